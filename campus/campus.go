@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+// Package campus is a directory of the buildings and rooms of the
+// University, extracted from the classrooms of the timetables.
+//
+//	dir := campus.NewDirectory(first, second)
+//	for _, n := range dir.Nearest(campus.Point{Lat: 44.4969, Lng: 11.3526}, 3) {
+//		fmt.Printf("%s is %.0f meters away\n", n.Building.Name, n.Meters)
+//	}
+package campus
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/cartabinaria/unibo-go/internal/text"
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+// Point is a geographical point, in degrees.
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// IsZero reports whether the point is unknown.
+func (p Point) IsZero() bool {
+	return p.Lat == 0 && p.Lng == 0
+}
+
+// Building is a building of the University.
+type Building struct {
+	Code     string `json:"code"`              // The code of the building (e.g. "331")
+	Name     string `json:"name"`              // The name of the building
+	Complex  string `json:"complex,omitempty"` // The complex (plesso) of the building (e.g. "RISORGIMENTO")
+	Address  string `json:"address,omitempty"` // The street and number (e.g. "Viale del Risorgimento, 2")
+	City     string `json:"city,omitempty"`
+	Province string `json:"province,omitempty"`
+	CAP      string `json:"cap,omitempty"`
+	Location Point  `json:"location,omitzero"` // The coordinates of the building. The zero value means unknown.
+}
+
+// Directory holds the buildings and the rooms found in a set of
+// timetables, without duplicates. A Directory is not safe for concurrent use.
+//
+// The rooms are indexed by a timetable.RoomIndex, so they are identified as
+// in the timetables: a classroom without a code is identified by its building
+// and name.
+type Directory struct {
+	buildings map[string]Building
+	rooms     *timetable.RoomIndex
+}
+
+// NewDirectory returns a directory of the buildings and the rooms of the
+// timetables.
+func NewDirectory(timetables ...timetable.Timetable) *Directory {
+	d := &Directory{
+		buildings: make(map[string]Building),
+		rooms:     timetable.NewRoomIndex(),
+	}
+	for _, t := range timetables {
+		d.Add(t)
+	}
+	return d
+}
+
+// Add adds the buildings and the rooms of the timetable to the directory.
+// Buildings without a code are ignored, and the first data seen for each
+// building is kept, filling the fields found empty.
+func (d *Directory) Add(t timetable.Timetable) {
+	d.rooms.Add(t)
+	for _, e := range t {
+		for _, c := range e.Classrooms {
+			d.addBuilding(c)
+		}
+	}
+}
+
+func (d *Directory) addBuilding(c timetable.Classroom) {
+	raw := c.Raw.Building
+	if raw.Code == "" {
+		return
+	}
+
+	b := d.buildings[raw.Code]
+	b.Code = raw.Code
+	b.Name = cmp.Or(b.Name, raw.Description, c.BuildingDesc)
+	b.Complex = cmp.Or(b.Complex, raw.Plesso)
+	b.Address = cmp.Or(b.Address, raw.Via, c.AddressDesc)
+	b.City = cmp.Or(b.City, raw.Comune)
+	b.Province = cmp.Or(b.Province, raw.Provincia)
+	b.CAP = cmp.Or(b.CAP, raw.CAP)
+	if b.Location.IsZero() {
+		b.Location = Point{Lat: raw.Geo.Lat, Lng: raw.Geo.Lng}
+	}
+	d.buildings[raw.Code] = b
+}
+
+// Building returns the building with the given code, and whether it was found.
+func (d *Directory) Building(code string) (Building, bool) {
+	b, ok := d.buildings[code]
+	return b, ok
+}
+
+// Buildings returns all the buildings, sorted by code.
+func (d *Directory) Buildings() []Building {
+	buildings := make([]Building, 0, len(d.buildings))
+	for _, b := range d.buildings {
+		buildings = append(buildings, b)
+	}
+	slices.SortFunc(buildings, func(a, b Building) int { return cmp.Compare(a.Code, b.Code) })
+	return buildings
+}
+
+// Room returns the room with the given code (see timetable.Room.Code), and
+// whether it was found.
+func (d *Directory) Room(code string) (timetable.Room, bool) {
+	return d.rooms.Room(code)
+}
+
+// Rooms returns all the rooms, sorted by building and name.
+func (d *Directory) Rooms() []timetable.Room {
+	return d.rooms.Rooms()
+}
+
+// RoomsIn returns the rooms of the building with the given code, sorted by
+// name.
+func (d *Directory) RoomsIn(building string) []timetable.Room {
+	return slices.DeleteFunc(d.Rooms(), func(r timetable.Room) bool { return r.Building.Code != building })
+}
+
+// Locate returns the building matching a free-text location, such as
+// exams.Exam.Location (e.g. "Aula 6.2 - Viale del Risorgimento 2 ; Bologna"),
+// and whether one was found.
+//
+// The match is heuristic: the address, the name and the complex of each
+// building, and the names of its rooms, are searched in the location,
+// ignoring case, accents and punctuation. The building matching best is
+// returned; ties go to the lowest code.
+func (d *Directory) Locate(location string) (Building, bool) {
+	normalized := " " + text.Normalize(location) + " "
+	contains := func(s string) bool {
+		s = text.Normalize(s)
+		return s != "" && strings.Contains(normalized, " "+s+" ")
+	}
+
+	scores := make(map[string]int, len(d.buildings))
+	for code, b := range d.buildings {
+		switch {
+		case contains(b.Address):
+			scores[code] += 4
+		case contains(street(b.Address)):
+			scores[code] += 1
+		}
+		if contains(b.Name) {
+			scores[code] += 3
+		}
+		if contains(b.Complex) {
+			scores[code] += 1
+		}
+	}
+	for _, r := range d.rooms.Rooms() {
+		if _, ok := d.buildings[r.Building.Code]; ok && contains(r.Name) {
+			scores[r.Building.Code] += 2
+		}
+	}
+
+	var best Building
+	bestScore := 0
+	for _, b := range d.Buildings() {
+		if scores[b.Code] > bestScore {
+			best, bestScore = b, scores[b.Code]
+		}
+	}
+	return best, bestScore > 0
+}
+
+// street returns the normalized address without the street number, e.g.
+// "via zamboni" for "Via Zamboni, 33".
+func street(address string) string {
+	fields := strings.Fields(text.Normalize(address))
+	for len(fields) > 0 && strings.IndexFunc(fields[len(fields)-1], unicode.IsDigit) >= 0 {
+		fields = fields[:len(fields)-1]
+	}
+	return strings.Join(fields, " ")
+}
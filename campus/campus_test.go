@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package campus
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+var (
+	risorgimento = timetable.Building{
+		Code:        "331",
+		Description: "Facoltà di Ingegneria dell'Università di Bologna",
+		Plesso:      "RISORGIMENTO",
+		Via:         "Viale del Risorgimento, 2",
+		Comune:      "Bologna",
+		Geo:         timetable.Geo{Lat: 44.4903628, Lng: 11.3289228},
+	}
+	zamboni = timetable.Building{
+		Code:        "101",
+		Description: "Palazzo Poggi",
+		Via:         "Via Zamboni, 33",
+		Comune:      "Bologna",
+		Geo:         timetable.Geo{Lat: 44.4969, Lng: 11.3526},
+	}
+)
+
+func classroom(code, name string, seats int, building timetable.Building) timetable.Classroom {
+	return timetable.Classroom{ResourceDesc: name, Raw: timetable.RawClassroom{Code: code, Seats: seats, Building: building}}
+}
+
+func testDirectory() *Directory {
+	first := timetable.Timetable{
+		{CodModulo: "A", Classrooms: []timetable.Classroom{classroom("331_1", "AULA 6.2", 250, risorgimento)}},
+		{CodModulo: "B", Classrooms: []timetable.Classroom{classroom("101_1", "AULA III", 80, zamboni)}},
+	}
+	second := timetable.Timetable{
+		{CodModulo: "C", Classrooms: []timetable.Classroom{
+			classroom("331_1", "AULA 6.2", 250, risorgimento),
+			classroom("331_2", "LAB 4", 40, risorgimento),
+		}},
+	}
+	return NewDirectory(first, second)
+}
+
+func TestDirectory(t *testing.T) {
+	dir := testDirectory()
+
+	buildings := dir.Buildings()
+	require.Len(t, buildings, 2, "the buildings should be deduplicated")
+	assert.Equal(t, "101", buildings[0].Code)
+	assert.Equal(t, "Palazzo Poggi", buildings[0].Name)
+	assert.Equal(t, Point{Lat: 44.4903628, Lng: 11.3289228}, buildings[1].Location)
+
+	assert.Len(t, dir.Rooms(), 3, "the rooms should be deduplicated")
+	rooms := dir.RoomsIn("331")
+	require.Len(t, rooms, 2)
+	assert.Equal(t, "AULA 6.2", rooms[0].Name)
+	assert.Equal(t, 250, rooms[0].Seats)
+}
+
+func TestDirectoryCodelessRoom(t *testing.T) {
+	lesson := timetable.Event{CodModulo: "A", Classrooms: []timetable.Classroom{
+		{BuildingDesc: "Palazzo Poggi", ResourceDesc: "AULA MAGNA", Raw: timetable.RawClassroom{Building: zamboni}},
+	}}
+	dir := NewDirectory(timetable.Timetable{lesson})
+
+	index := timetable.NewRoomIndex(timetable.Timetable{lesson})
+	want, ok := index.Room("Palazzo Poggi/AULA MAGNA")
+	require.True(t, ok)
+
+	room, ok := dir.Room("Palazzo Poggi/AULA MAGNA")
+	require.True(t, ok, "a room without a code should be identified as in timetable.RoomIndex")
+	assert.Equal(t, want, room)
+	assert.Len(t, dir.RoomsIn("101"), 1)
+}
+
+func TestNearest(t *testing.T) {
+	dir := testDirectory()
+
+	// Piazza Maggiore
+	nearby := dir.Nearest(Point{Lat: 44.4938, Lng: 11.3426}, 0)
+	require.Len(t, nearby, 2)
+	assert.Equal(t, "101", nearby[0].Building.Code)
+	assert.Less(t, nearby[0].Meters, nearby[1].Meters)
+
+	assert.Len(t, dir.Nearest(Point{}, 1), 1, "the results should be limited")
+}
+
+func TestDistance(t *testing.T) {
+	// One degree along a meridian
+	assert.InDelta(t, 111195, Distance(Point{Lat: 44, Lng: 11}, Point{Lat: 45, Lng: 11}), 1)
+	// A quarter of the equator
+	assert.InDelta(t, 10007543, Distance(Point{Lat: 0, Lng: 0}, Point{Lat: 0, Lng: 90}), 1)
+	assert.Zero(t, Distance(Point{Lat: 44, Lng: 11}, Point{Lat: 44, Lng: 11}))
+}
+
+func TestGeoJSON(t *testing.T) {
+	data, err := json.Marshal(testDirectory().GeoJSON())
+	require.NoError(t, err)
+
+	var decoded struct {
+		Type     string
+		Features []struct {
+			Geometry struct {
+				Type        string
+				Coordinates []float64
+			}
+			Properties map[string]any
+		}
+	}
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "FeatureCollection", decoded.Type)
+	require.Len(t, decoded.Features, 2)
+	assert.Equal(t, []float64{11.3289228, 44.4903628}, decoded.Features[1].Geometry.Coordinates, "the longitude should come first")
+	assert.Equal(t, "331", decoded.Features[1].Properties["code"])
+	assert.EqualValues(t, 2, decoded.Features[1].Properties["rooms"])
+}
+
+func TestLocate(t *testing.T) {
+	dir := testDirectory()
+
+	tests := []struct {
+		location string
+		want     string
+	}{
+		{"Aula 6.2 - Viale del Risorgimento 2 ; Bologna", "331"},
+		{"LAB 4", "331"},
+		{"Aula III - Via Zamboni, 33 - Bologna", "101"},
+		{"PALAZZO POGGI", "101"},
+		{"via zamboni", "101"},
+	}
+	for _, test := range tests {
+		b, ok := dir.Locate(test.location)
+		if assert.True(t, ok, "no building found for %q", test.location) {
+			assert.Equal(t, test.want, b.Code, "wrong building for %q", test.location)
+		}
+	}
+
+	_, ok := dir.Locate("ONLINE")
+	assert.False(t, ok, "online exams have no building")
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package campus
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// earthRadius is the mean radius of the Earth, in meters.
+const earthRadius = 6371000
+
+// Distance returns the great-circle distance between a and b, in meters,
+// using the haversine formula.
+func Distance(a, b Point) float64 {
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := rad(b.Lat - a.Lat)
+	dLng := rad(b.Lng - a.Lng)
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(rad(a.Lat))*math.Cos(rad(b.Lat))*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// Nearby is a building and its distance from a point.
+type Nearby struct {
+	Building Building
+	Meters   float64
+}
+
+// Nearest returns the limit buildings nearest to p, from the nearest. If
+// limit is not positive, all the buildings are returned. Buildings with an
+// unknown location are skipped.
+func (d *Directory) Nearest(p Point, limit int) []Nearby {
+	var nearby []Nearby
+	for _, b := range d.Buildings() {
+		if !b.Location.IsZero() {
+			nearby = append(nearby, Nearby{Building: b, Meters: Distance(p, b.Location)})
+		}
+	}
+	slices.SortStableFunc(nearby, func(a, b Nearby) int { return cmp.Compare(a.Meters, b.Meters) })
+
+	if limit > 0 && len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+	return nearby
+}
+
+// FeatureCollection is a GeoJSON feature collection (RFC 7946).
+type FeatureCollection struct {
+	Type     string    `json:"type"` // Always "FeatureCollection"
+	Features []Feature `json:"features"`
+}
+
+// Feature is a GeoJSON feature.
+type Feature struct {
+	Type       string         `json:"type"` // Always "Feature"
+	Geometry   Geometry       `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+// Geometry is a GeoJSON point geometry.
+type Geometry struct {
+	Type        string     `json:"type"`        // Always "Point"
+	Coordinates [2]float64 `json:"coordinates"` // Longitude and latitude, in this order
+}
+
+// GeoJSON returns the buildings with a known location as a GeoJSON feature
+// collection, ready to be encoded with encoding/json. The properties of each
+// feature are the fields of the building, and the number of its rooms.
+func (d *Directory) GeoJSON() FeatureCollection {
+	rooms := make(map[string]int)
+	for _, r := range d.rooms.Rooms() {
+		rooms[r.Building.Code]++
+	}
+
+	collection := FeatureCollection{Type: "FeatureCollection", Features: []Feature{}}
+	for _, b := range d.Buildings() {
+		if b.Location.IsZero() {
+			continue
+		}
+		collection.Features = append(collection.Features, Feature{
+			Type: "Feature",
+			Geometry: Geometry{
+				Type:        "Point",
+				Coordinates: [2]float64{b.Location.Lng, b.Location.Lat},
+			},
+			Properties: map[string]any{
+				"code":     b.Code,
+				"name":     b.Name,
+				"complex":  b.Complex,
+				"address":  b.Address,
+				"city":     b.City,
+				"province": b.Province,
+				"cap":      b.CAP,
+				"rooms":    rooms[b.Code],
+			},
+		})
+	}
+	return collection
+}
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/cartabinaria/unibo-go"
+)
+
+// WithToken authenticates every request with the given CKAN API token.
+//
+// A token is required by the write actions (e.g. CreatePackage) and to read
+// private datasets.
+//
+//	client := ckan.NewClient("https://ckan.example.org", ckan.WithToken(os.Getenv("CKAN_TOKEN")))
+func WithToken(token string) unibo.Option {
+	return unibo.WithHeader("Authorization", token)
+}
+
+// Action calls the given CKAN action with a POST request, sending params as a
+// JSON object, and returns its result.
+//
+// It is used internally by the write methods of Client, and can be used to
+// call actions that do not have a dedicated method.
+func Action[T any](ctx context.Context, c *Client, action string, params any) (*T, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode parameters: %w", err)
+	}
+
+	return post[T](ctx, c, action, "application/json", bytes.NewReader(body))
+}
+
+func post[T any](ctx context.Context, c *Client, action, contentType string, body io.Reader) (*T, error) {
+	url := fmt.Sprintf("%s/api/3/action/%s", c.baseURL, action)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := c.HTTPClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make request: %w", err)
+	}
+	defer res.Body.Close()
+
+	resp, err := decodeResponse[T](res)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp)
+}
+
+// compactParams converts v to a JSON object, dropping the zero values (null,
+// false, 0, empty strings, arrays and objects), so that a partially filled
+// struct such as Package only sends the fields that were set. The zero values
+// are dropped from the nested objects too, e.g. from the Resources and Tags of
+// a Package.
+func compactParams(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode parameters: %w", err)
+	}
+
+	var params map[string]any
+	err = json.Unmarshal(b, &params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode parameters: %w", err)
+	}
+
+	compactObject(params)
+	return params, nil
+}
+
+// compactObject drops the zero values from the object, recursively.
+func compactObject(object map[string]any) {
+	for key, value := range object {
+		if compactValue(value) {
+			delete(object, key)
+		}
+	}
+}
+
+// compactValue drops the zero values from the objects in value, recursively,
+// and reports whether value is a zero value itself. The elements of arrays
+// are kept, since their position can matter.
+func compactValue(value any) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !value
+	case float64:
+		return value == 0
+	case string:
+		return value == ""
+	case []any:
+		for _, element := range value {
+			if object, ok := element.(map[string]any); ok {
+				compactObject(object)
+			}
+		}
+		return len(value) == 0
+	case map[string]any:
+		compactObject(value)
+		return len(value) == 0
+	}
+	return false
+}
+
+// CreatePackage creates a new package (package_create) and returns it.
+//
+// Only the fields of pkg that are not zero are sent, so the defaults of the
+// instance apply to the others (e.g. packages are public unless Private is
+// set). Name is required, and OwnerOrg is usually required too, depending on
+// the configuration of the instance.
+func (c *Client) CreatePackage(ctx context.Context, pkg Package) (*Package, error) {
+	params, err := compactParams(pkg)
+	if err != nil {
+		return nil, err
+	}
+	return Action[Package](ctx, c, "package_create", params)
+}
+
+// PatchPackage updates the given fields of a package (package_patch) and
+// returns the updated package. The fields not in the map are left unchanged.
+//
+//	pkg, err := client.PatchPackage(ctx, "degree-programmes", map[string]any{"notes": "..."})
+func (c *Client) PatchPackage(ctx context.Context, id string, fields map[string]any) (*Package, error) {
+	params := make(map[string]any, len(fields)+1)
+	for key, value := range fields {
+		params[key] = value
+	}
+	params["id"] = id
+	return Action[Package](ctx, c, "package_patch", params)
+}
+
+// DeletePackage deletes a package (package_delete).
+func (c *Client) DeletePackage(ctx context.Context, id string) error {
+	_, err := Action[any](ctx, c, "package_delete", map[string]any{"id": id})
+	return err
+}
+
+// CreateResource creates a new resource (resource_create) linking to
+// res.URL, and returns it. PackageID is required.
+//
+// Only the fields of res that are not zero are sent. Use UploadResource to
+// upload a file instead.
+func (c *Client) CreateResource(ctx context.Context, res Resource) (*Resource, error) {
+	params, err := compactParams(res)
+	if err != nil {
+		return nil, err
+	}
+	return Action[Resource](ctx, c, "resource_create", params)
+}
+
+// UploadResource creates a new resource (resource_create) uploading the
+// content of r as a file named filename, and returns it. PackageID is required.
+//
+// The file is streamed to the server in a multipart/form-data request.
+func (c *Client) UploadResource(ctx context.Context, res Resource, filename string, r io.Reader) (*Resource, error) {
+	params, err := compactParams(res)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		pw.CloseWithError(writeMultipart(mw, params, filename, r))
+	}()
+
+	resource, err := post[Resource](ctx, c, "resource_create", mw.FormDataContentType(), pr)
+	// Unblock the writer if the request failed before reading the whole body
+	pr.CloseWithError(io.ErrClosedPipe)
+	return resource, err
+}
+
+// writeMultipart writes params as form fields, followed by the "upload" file.
+func writeMultipart(mw *multipart.Writer, params map[string]any, filename string, r io.Reader) error {
+	for key, value := range params {
+		var field string
+		if s, ok := value.(string); ok {
+			field = s
+		} else {
+			b, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			field = string(b)
+		}
+
+		if err := mw.WriteField(key, field); err != nil {
+			return err
+		}
+	}
+
+	fw, err := mw.CreateFormFile("upload", filename)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(fw, r); err != nil {
+		return err
+	}
+
+	return mw.Close()
+}
+
+// PatchResource updates the given fields of a resource (resource_patch) and
+// returns the updated resource. The fields not in the map are left unchanged.
+func (c *Client) PatchResource(ctx context.Context, id string, fields map[string]any) (*Resource, error) {
+	params := make(map[string]any, len(fields)+1)
+	for key, value := range fields {
+		params[key] = value
+	}
+	params["id"] = id
+	return Action[Resource](ctx, c, "resource_patch", params)
+}
+
+// Organization member roles, used by CreateOrganizationMember.
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleMember = "member"
+)
+
+// CreateOrganizationMember adds a user to an organization with the given role
+// (organization_member_create), or changes their role if they are already a
+// member. The role is one of RoleAdmin, RoleEditor and RoleMember.
+func (c *Client) CreateOrganizationMember(ctx context.Context, organizationID, username, role string) (*Member, error) {
+	return Action[Member](ctx, c, "organization_member_create", map[string]any{
+		"id":       organizationID,
+		"username": username,
+		"role":     role,
+	})
+}
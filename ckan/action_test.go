@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveAction starts a CKAN stand-in that checks the token and method of the
+// request and passes it to handler, which returns the result of the action.
+func serveAction(t *testing.T, action string, handler func(r *http.Request) any) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/3/action/"+action, r.URL.Path, "Unexpected request path")
+		require.Equal(t, http.MethodPost, r.Method, "Expected a POST request")
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"success": false, "error": {"__type": "Authorization Error", "message": "Access denied"}}`))
+			return
+		}
+
+		err := json.NewEncoder(w).Encode(map[string]any{"success": true, "result": handler(r)})
+		require.NoError(t, err, "Failed to write response")
+	}))
+
+	t.Cleanup(func() {
+		srv.Close()
+	})
+
+	return srv.URL
+}
+
+func decodeParams(t *testing.T, r *http.Request) map[string]any {
+	require.Equal(t, "application/json", r.Header.Get("Content-Type"), "Expected a JSON body")
+
+	var params map[string]any
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&params), "Failed to decode parameters")
+	return params
+}
+
+func TestCreatePackage(t *testing.T) {
+	url := serveAction(t, "package_create", func(r *http.Request) any {
+		params := decodeParams(t, r)
+		assert.Equal(t, map[string]any{"name": "degree-programmes", "owner_org": "aform"}, params, "Expected only the set fields to be sent")
+		return map[string]any{"id": "1", "name": params["name"]}
+	})
+
+	client := NewClient(url, WithToken("secret"))
+	pkg, err := client.CreatePackage(context.Background(), Package{Name: "degree-programmes", OwnerOrg: "aform"})
+	require.NoError(t, err, "CreatePackage should not return an error")
+	assert.Equal(t, "1", pkg.ID, "Expected package ID to match")
+
+	_, err = NewClient(url).CreatePackage(context.Background(), Package{Name: "degree-programmes"})
+	assert.True(t, IsAuthorizationError(err), "Expected an authorization error without token")
+}
+
+func TestCreatePackageNested(t *testing.T) {
+	url := serveAction(t, "package_create", func(r *http.Request) any {
+		params := decodeParams(t, r)
+		assert.Equal(t, map[string]any{
+			"name":      "degree-programmes",
+			"resources": []any{map[string]any{"url": "https://example.com/degrees.csv", "format": "CSV"}},
+			"tags":      []any{map[string]any{"name": "degrees"}},
+		}, params, "Expected only the set fields of the nested objects to be sent")
+		return map[string]any{"id": "1", "name": params["name"]}
+	})
+
+	client := NewClient(url, WithToken("secret"))
+	_, err := client.CreatePackage(context.Background(), Package{
+		Name:      "degree-programmes",
+		Resources: []Resource{{URL: "https://example.com/degrees.csv", Format: "CSV"}},
+		Tags:      []Tag{{Name: "degrees"}},
+	})
+	require.NoError(t, err, "CreatePackage should not return an error")
+}
+
+func TestPatchAndDeletePackage(t *testing.T) {
+	url := serveAction(t, "package_patch", func(r *http.Request) any {
+		params := decodeParams(t, r)
+		assert.Equal(t, map[string]any{"id": "1", "notes": "Updated"}, params, "Expected only the patched fields to be sent")
+		return map[string]any{"id": "1", "notes": "Updated"}
+	})
+
+	pkg, err := NewClient(url, WithToken("secret")).PatchPackage(context.Background(), "1", map[string]any{"notes": "Updated"})
+	require.NoError(t, err, "PatchPackage should not return an error")
+	assert.Equal(t, "Updated", pkg.Notes, "Expected notes to match")
+
+	url = serveAction(t, "package_delete", func(r *http.Request) any {
+		assert.Equal(t, map[string]any{"id": "1"}, decodeParams(t, r), "Expected the package ID to be sent")
+		return nil
+	})
+
+	err = NewClient(url, WithToken("secret")).DeletePackage(context.Background(), "1")
+	assert.NoError(t, err, "DeletePackage should not return an error")
+}
+
+func TestUploadResource(t *testing.T) {
+	url := serveAction(t, "resource_create", func(r *http.Request) any {
+		file, header, err := r.FormFile("upload")
+		require.NoError(t, err, "Expected an uploaded file")
+		content, err := io.ReadAll(file)
+		require.NoError(t, err, "Failed to read uploaded file")
+
+		assert.Equal(t, "corsi.csv", header.Filename, "Expected filename to match")
+		assert.Equal(t, "a,b\n1,2\n", string(content), "Expected file content to match")
+		assert.Equal(t, "1", r.FormValue("package_id"), "Expected package ID to match")
+		assert.Equal(t, "CSV", r.FormValue("format"), "Expected format to match")
+
+		return map[string]any{"id": "2", "package_id": "1", "format": "CSV"}
+	})
+
+	client := NewClient(url, WithToken("secret"))
+	res, err := client.UploadResource(context.Background(), Resource{PackageID: "1", Format: "CSV"}, "corsi.csv", strings.NewReader("a,b\n1,2\n"))
+	require.NoError(t, err, "UploadResource should not return an error")
+	assert.Equal(t, "2", res.ID, "Expected resource ID to match")
+}
+
+func TestCreateOrganizationMember(t *testing.T) {
+	url := serveAction(t, "organization_member_create", func(r *http.Request) any {
+		assert.Equal(t, map[string]any{"id": "aform", "username": "admin", "role": "editor"}, decodeParams(t, r), "Expected member parameters to match")
+		return map[string]any{"id": "3", "table_name": "user", "capacity": "editor", "state": "active"}
+	})
+
+	member, err := NewClient(url, WithToken("secret")).CreateOrganizationMember(context.Background(), "aform", "admin", RoleEditor)
+	require.NoError(t, err, "CreateOrganizationMember should not return an error")
+	assert.Equal(t, "editor", member.Capacity, "Expected capacity to match")
+}
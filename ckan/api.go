@@ -5,19 +5,136 @@
 package ckan
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/cartabinaria/unibo-go"
 )
 
-var (
-	httpClient = &http.Client{} // The HTTP client used to make requests
+// Error types returned by the CKAN API in the __type field of an ApiError.
+const (
+	ErrorTypeNotFound      = "Not Found Error"
+	ErrorTypeValidation    = "Validation Error"
+	ErrorTypeAuthorization = "Authorization Error"
+	ErrorTypeSearchQuery   = "Search Query Error"
+	ErrorTypeSearch        = "Search Error"
+	ErrorTypeIntegrity     = "Integrity Error"
 )
 
 // ApiError represents an error returned by the CKAN API.
+//
+// It implements the error interface, so it can be retrieved from the errors
+// returned by the Client methods with errors.As:
+//
+//	var apiErr *ckan.ApiError
+//	if errors.As(err, &apiErr) && apiErr.Type == ckan.ErrorTypeValidation {
+//		fmt.Println(apiErr.Fields)
+//	}
+//
+// For the most common cases, use IsNotFound, IsValidationError and IsAuthorizationError.
 type ApiError struct {
-	Message string `json:"message"` // The error message returned by the API
-	Type    string `json:"__type"`  // The type of error, e.g., "NotFound", "ValidationError"
+	Message    string              `json:"message"` // The error message returned by the API
+	Type       string              `json:"__type"`  // The type of error, e.g., "Not Found Error", "Validation Error"
+	StatusCode int                 `json:"-"`       // The HTTP status code of the response
+	Fields     map[string][]string `json:"-"`       // The field-level errors of a "Validation Error", keyed by field name
+}
+
+// UnmarshalJSON decodes an ApiError. CKAN reports field-level validation
+// errors as additional keys of the error object, which are collected in Fields.
+func (e *ApiError) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*e = ApiError{}
+	for key, value := range raw {
+		switch key {
+		case "message":
+			if err := json.Unmarshal(value, &e.Message); err != nil {
+				return fmt.Errorf("unable to decode error message: %w", err)
+			}
+		case "__type":
+			if err := json.Unmarshal(value, &e.Type); err != nil {
+				return fmt.Errorf("unable to decode error type: %w", err)
+			}
+		default:
+			if e.Fields == nil {
+				e.Fields = make(map[string][]string)
+			}
+			e.Fields[key] = decodeFieldErrors(value)
+		}
+	}
+
+	return nil
+}
+
+// decodeFieldErrors decodes the errors of a single field, which are usually a
+// list of strings. Other shapes (e.g. the nested errors of resources) are kept
+// as raw JSON.
+func decodeFieldErrors(value json.RawMessage) []string {
+	var list []string
+	if err := json.Unmarshal(value, &list); err == nil {
+		return list
+	}
+	var single string
+	if err := json.Unmarshal(value, &single); err == nil {
+		return []string{single}
+	}
+	return []string{string(value)}
+}
+
+// Error returns a description of the error, including the field-level errors.
+func (e *ApiError) Error() string {
+	var b strings.Builder
+	b.WriteString("API call failed")
+	if e.Type != "" {
+		b.WriteString(" (" + e.Type + ")")
+	}
+	if e.Message != "" {
+		b.WriteString(": " + e.Message)
+	} else if e.Type == "" && e.StatusCode != 0 && e.StatusCode != http.StatusOK {
+		fmt.Fprintf(&b, ": unexpected status code: %d", e.StatusCode)
+	}
+
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	for _, field := range fields {
+		fmt.Fprintf(&b, "; %s: %s", field, strings.Join(e.Fields[field], ", "))
+	}
+
+	return b.String()
+}
+
+// IsNotFound reports whether err is an ApiError caused by a missing object,
+// e.g. a package that does not exist.
+func IsNotFound(err error) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) &&
+		(apiErr.Type == ErrorTypeNotFound || apiErr.StatusCode == http.StatusNotFound)
+}
+
+// IsValidationError reports whether err is an ApiError caused by invalid
+// parameters. See ApiError.Fields for the details.
+func IsValidationError(err error) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) && apiErr.Type == ErrorTypeValidation
+}
+
+// IsAuthorizationError reports whether err is an ApiError caused by missing
+// permissions or credentials.
+func IsAuthorizationError(err error) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) &&
+		(apiErr.Type == ErrorTypeAuthorization || apiErr.StatusCode == http.StatusForbidden)
 }
 
 // ApiResponse is a generic structure for API responses from the CKAN API.
@@ -37,26 +154,46 @@ type ApiResponse[T any] struct {
 
 // RequestRaw queries the CKAN API and returns the raw ApiResponse.
 //
+// CKAN answers failed calls with a non-200 status code and an ApiResponse
+// describing the error: in this case the response is returned as well, with
+// the StatusCode of its Error set. If the body is not a valid ApiResponse,
+// an *ApiError holding only the status code is returned.
+//
 // For a more idiomatic Go experience, consider using Request.
 func RequestRaw[T any](url string) (*ApiResponse[T], error) {
-	res, err := httpClient.Get(url)
+	return requestRaw[T](context.Background(), unibo.DefaultClient, url)
+}
+
+func requestRaw[T any](ctx context.Context, client *unibo.Client, url string) (*ApiResponse[T], error) {
+	res, err := client.Get(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("unable to make request: %w", err)
 	}
+	defer res.Body.Close()
+
+	return decodeResponse[T](res)
+}
+
+// decodeResponse decodes the ApiResponse in the body of res.
+//
+// See RequestRaw for how non-200 status codes are handled.
+func decodeResponse[T any](res *http.Response) (*ApiResponse[T], error) {
+	var response ApiResponse[T]
+	err := json.NewDecoder(res.Body).Decode(&response)
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", res.StatusCode)
+		if err != nil || response.Error == nil {
+			return nil, &ApiError{StatusCode: res.StatusCode}
+		}
+		response.Error.StatusCode = res.StatusCode
+		return &response, nil
 	}
 
-	var response ApiResponse[T]
-	err = json.NewDecoder(res.Body).Decode(&response)
 	if err != nil {
 		return nil, fmt.Errorf("unable to decode response: %w", err)
 	}
-
-	err = res.Body.Close()
-	if err != nil {
-		return nil, fmt.Errorf("unable to close response body: %w", err)
+	if response.Error != nil {
+		response.Error.StatusCode = res.StatusCode
 	}
 
 	return &response, nil
@@ -71,23 +208,32 @@ func RequestRaw[T any](url string) (*ApiResponse[T], error) {
 //	pkgs, err := client.GetPackageList()
 //	...
 //
-// If the API call was not successful, it returns a standard error
-// containing the error message from the API response.
+// If the API call was not successful, it returns an *ApiError
+// containing the error information from the API response.
 //
 // You should not need to use this function directly in most cases,
 // as it is primarily used internally by the Client methods.
 //
 // For a more advanced use case, consider using RequestRaw.
 func Request[T any](url string) (*T, error) {
-	resp, err := RequestRaw[T](url)
+	return request[T](context.Background(), unibo.DefaultClient, url)
+}
+
+func request[T any](ctx context.Context, client *unibo.Client, url string) (*T, error) {
+	resp, err := requestRaw[T](ctx, client, url)
 	if err != nil {
 		return nil, err
 	}
+	return result(resp)
+}
+
+// result returns the result of a successful response, or its error.
+func result[T any](resp *ApiResponse[T]) (*T, error) {
 	if !resp.Success {
 		if resp.Error == nil {
 			return nil, fmt.Errorf("API call was not successful, but no error information provided")
 		}
-		return nil, fmt.Errorf("API call failed: %s", resp.Error.Message)
+		return nil, resp.Error
 	}
 
 	return resp.Result, nil
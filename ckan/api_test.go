@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveStatus(t *testing.T, status int, body string) string {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		require.NoError(t, err, "Failed to write response")
+	}))
+
+	t.Cleanup(func() {
+		srv.Close()
+	})
+
+	return srv.URL
+}
+
+func TestNotFoundError(t *testing.T) {
+	json := `{"help": "https://demo.ckan.org/api/3/action/help_show?name=package_show", "success": false, "error": {"message": "Not found", "__type": "Not Found Error"}}`
+	client := NewClient(serveStatus(t, http.StatusNotFound, json))
+
+	pkg, err := client.GetPackage("missing")
+	require.Error(t, err, "GetPackage should return an error")
+	assert.Nil(t, pkg, "GetPackage should not return a package")
+
+	var apiErr *ApiError
+	require.True(t, errors.As(err, &apiErr), "Expected an *ApiError")
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode, "Expected status code to match")
+	assert.Equal(t, ErrorTypeNotFound, apiErr.Type, "Expected error type to match")
+	assert.Equal(t, "Not found", apiErr.Message, "Expected error message to match")
+	assert.True(t, IsNotFound(err), "Expected IsNotFound to be true")
+	assert.False(t, IsValidationError(err), "Expected IsValidationError to be false")
+}
+
+func TestValidationError(t *testing.T) {
+	json := `{"success": false, "error": {"__type": "Validation Error", "name": ["That URL is already in use."], "resources": [{}, {"url": ["Missing value"]}]}}`
+	client := NewClient(serveStatus(t, http.StatusConflict, json))
+
+	_, err := client.GetPackage("1")
+	require.Error(t, err, "GetPackage should return an error")
+	assert.True(t, IsValidationError(err), "Expected IsValidationError to be true")
+	assert.False(t, IsNotFound(err), "Expected IsNotFound to be false")
+
+	var apiErr *ApiError
+	require.True(t, errors.As(err, &apiErr), "Expected an *ApiError")
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode, "Expected status code to match")
+	assert.Equal(t, []string{"That URL is already in use."}, apiErr.Fields["name"], "Expected field errors to match")
+	assert.Equal(t, []string{`[{}, {"url": ["Missing value"]}]`}, apiErr.Fields["resources"], "Expected nested field errors to be kept raw")
+	assert.Equal(t, `API call failed (Validation Error); name: That URL is already in use.; resources: [{}, {"url": ["Missing value"]}]`, err.Error())
+}
+
+func TestStatusErrorWithoutBody(t *testing.T) {
+	client := NewClient(serveStatus(t, http.StatusNotFound, "<html>Not Found</html>"))
+
+	_, err := client.GetPackage("1")
+	require.Error(t, err, "GetPackage should return an error")
+	assert.True(t, IsNotFound(err), "Expected IsNotFound to be true")
+	assert.Equal(t, "API call failed: unexpected status code: 404", err.Error())
+}
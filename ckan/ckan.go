@@ -202,9 +202,23 @@ type Vocabulary struct {
 
 // PackageSearch represents the result of a package search query in CKAN.
 type PackageSearch struct {
-	Count        int            `json:"count"`         // The total number of packages matching the search criteria
-	Results      []Package      `json:"results"`       // The list of packages returned by the search
-	SearchFacets map[string]any `json:"search_facets"` // Additional facets for filtering search results, e.g., by tags, groups, etc.
+	Count        int              `json:"count"`         // The total number of packages matching the search criteria
+	Results      []Package        `json:"results"`       // The list of packages returned by the search
+	SearchFacets map[string]Facet `json:"search_facets"` // The facets requested with PackageSearchOptions.FacetFields, keyed by field
+}
+
+// Facet represents the values of a field among the results of a search,
+// e.g. the tags or the organizations of the matching packages.
+type Facet struct {
+	Title string      `json:"title"` // The name of the field
+	Items []FacetItem `json:"items"` // The values of the field, with the number of results for each
+}
+
+// FacetItem represents a value of a Facet.
+type FacetItem struct {
+	Name        string `json:"name"`         // The value of the field, e.g., the name of a tag
+	DisplayName string `json:"display_name"` // A human readable version of the value
+	Count       int    `json:"count"`        // The number of results with this value
 }
 
 // ResourceSearch represents the result of a resource search query in CKAN.
@@ -218,3 +232,13 @@ type TagSearch struct {
 	Count   int   `json:"count"`   // The total number of tags matching the search criteria
 	Results []Tag `json:"results"` // The list of tags returned by the search
 }
+
+// Member represents the membership of an object, such as a User, in a Group or Organization.
+type Member struct {
+	ID        string `json:"id"`         // The unique identifier for the membership
+	TableName string `json:"table_name"` // The type of the member, e.g., "user", "package"
+	TableID   string `json:"table_id"`   // The ID of the member
+	Capacity  string `json:"capacity"`   // The role of the member, e.g., "admin", "editor", "member"
+	GroupID   string `json:"group_id"`   // The ID of the group or organization
+	State     string `json:"state"`      // The state of the membership, e.g., "active", "deleted"
+}
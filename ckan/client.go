@@ -4,69 +4,151 @@
 
 package ckan
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/cartabinaria/unibo-go"
+)
 
 // Client represents a CKAN client that can interact with a CKAN instance.
 //
 // It provides methods to fetch data such as packages, groups, organizations,
 // tags, users, licenses, and vocabularies from the CKAN API.
+//
+// Every method has a Context variant that binds the request to a context.Context.
 type Client struct {
-	baseURL string // The base URL of the CKAN instance
+	baseURL  string        // The base URL of the CKAN instance
+	client   *unibo.Client // The client used to perform HTTP requests
+	pageSize int           // The number of items fetched per request by the iterators
 }
 
 // NewClient creates a new CKAN client with the given base URL.
-func NewClient(baseURL string) *Client {
-	return &Client{baseURL: baseURL}
+//
+// The options configure the underlying HTTP client, e.g. its timeout or
+// User-Agent. Without options, the client uses unibo.DefaultClient.
+func NewClient(baseURL string, opts ...unibo.Option) *Client {
+	c := &Client{baseURL: baseURL}
+	if len(opts) > 0 {
+		c.client = unibo.NewClient(opts...)
+	}
+	return c
+}
+
+// BaseURL returns the base URL of the CKAN instance.
+func (c *Client) BaseURL() string { return c.baseURL }
+
+// HTTPClient returns the client used to perform HTTP requests.
+func (c *Client) HTTPClient() *unibo.Client {
+	if c.client == nil {
+		return unibo.DefaultClient
+	}
+	return c.client
 }
 
 func (c *Client) GetPackageList() (*[]string, error) {
-	return Request[[]string](fmt.Sprintf("%s/api/3/action/package_list", c.baseURL))
+	return c.GetPackageListContext(context.Background())
 }
 func (c *Client) GetPackage(id string) (*Package, error) {
-	return Request[Package](fmt.Sprintf("%s/api/3/action/package_show?id=%s", c.baseURL, id))
+	return c.GetPackageContext(context.Background(), id)
 }
 func (c *Client) GetCurrentPackageListWithResources(limit, offset int) (*[]Package, error) {
-	return Request[[]Package](fmt.Sprintf("%s/api/3/action/current_package_list_with_resources?limit=%d&offset=%d", c.baseURL, limit, offset))
+	return c.GetCurrentPackageListWithResourcesContext(context.Background(), limit, offset)
 }
 func (c *Client) GetGroupList() (*[]string, error) {
-	return Request[[]string](fmt.Sprintf("%s/api/3/action/group_list", c.baseURL))
+	return c.GetGroupListContext(context.Background())
 }
 func (c *Client) GetGroup(id string) (*Group, error) {
-	return Request[Group](fmt.Sprintf("%s/api/3/action/group_show?id=%s", c.baseURL, id))
+	return c.GetGroupContext(context.Background(), id)
 }
 func (c *Client) GetOrganizationList() (*[]string, error) {
-	return Request[[]string](fmt.Sprintf("%s/api/3/action/organization_list", c.baseURL))
+	return c.GetOrganizationListContext(context.Background())
 }
 func (c *Client) GetOrganization(id string) (*Organization, error) {
-	return Request[Organization](fmt.Sprintf("%s/api/3/action/organization_show?id=%s", c.baseURL, id))
+	return c.GetOrganizationContext(context.Background(), id)
 }
 func (c *Client) GetTagList() (*[]string, error) {
-	return Request[[]string](fmt.Sprintf("%s/api/3/action/tag_list", c.baseURL))
+	return c.GetTagListContext(context.Background())
 }
 func (c *Client) GetTagShow(id string) (*Tag, error) {
-	return Request[Tag](fmt.Sprintf("%s/api/3/action/tag_show?id=%s", c.baseURL, id))
+	return c.GetTagShowContext(context.Background(), id)
 }
 func (c *Client) GetUserList() (*[]string, error) {
-	return Request[[]string](fmt.Sprintf("%s/api/3/action/user_list", c.baseURL))
+	return c.GetUserListContext(context.Background())
 }
 func (c *Client) GetUser(id string) (*User, error) {
-	return Request[User](fmt.Sprintf("%s/api/3/action/user_show?id=%s", c.baseURL, id))
+	return c.GetUserContext(context.Background(), id)
 }
 func (c *Client) GetLicenseList() (*[]License, error) {
-	return Request[[]License](fmt.Sprintf("%s/api/3/action/license_list", c.baseURL))
+	return c.GetLicenseListContext(context.Background())
 }
 func (c *Client) GetVocabularyList() (*[]Vocabulary, error) {
-	return Request[[]Vocabulary](fmt.Sprintf("%s/api/3/action/vocabulary_list", c.baseURL))
+	return c.GetVocabularyListContext(context.Background())
 }
 func (c *Client) GetVocabulary(id string) (*Vocabulary, error) {
-	return Request[Vocabulary](fmt.Sprintf("%s/api/3/action/vocabulary_show?id=%s", c.baseURL, id))
+	return c.GetVocabularyContext(context.Background(), id)
 }
 func (c *Client) GetPackageSearch(query string, rows, start int) (*PackageSearch, error) {
-	return Request[PackageSearch](fmt.Sprintf("%s/api/3/action/package_search?q=%s&rows=%d&start=%d", c.baseURL, query, rows, start))
+	return c.GetPackageSearchContext(context.Background(), query, rows, start)
 }
 func (c *Client) SearchResource(query string, limit, offset int) (*ResourceSearch, error) {
-	return Request[ResourceSearch](fmt.Sprintf("%s/api/3/action/resource_search?query=%s&limit=%d&offset=%d", c.baseURL, query, limit, offset))
+	return c.SearchResourceContext(context.Background(), query, limit, offset)
 }
 func (c *Client) SearchTag(query string, limit, offset int) (*TagSearch, error) {
-	return Request[TagSearch](fmt.Sprintf("%s/api/3/action/tag_search?query=%s&limit=%d&offset=%d", c.baseURL, query, limit, offset))
+	return c.SearchTagContext(context.Background(), query, limit, offset)
+}
+
+// The Context variants below bind the request to ctx.
+
+func (c *Client) GetPackageListContext(ctx context.Context) (*[]string, error) {
+	return request[[]string](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/package_list", c.baseURL))
+}
+func (c *Client) GetPackageContext(ctx context.Context, id string) (*Package, error) {
+	return request[Package](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/package_show?id=%s", c.baseURL, url.QueryEscape(id)))
+}
+func (c *Client) GetCurrentPackageListWithResourcesContext(ctx context.Context, limit, offset int) (*[]Package, error) {
+	return request[[]Package](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/current_package_list_with_resources?limit=%d&offset=%d", c.baseURL, limit, offset))
+}
+func (c *Client) GetGroupListContext(ctx context.Context) (*[]string, error) {
+	return request[[]string](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/group_list", c.baseURL))
+}
+func (c *Client) GetGroupContext(ctx context.Context, id string) (*Group, error) {
+	return request[Group](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/group_show?id=%s", c.baseURL, url.QueryEscape(id)))
+}
+func (c *Client) GetOrganizationListContext(ctx context.Context) (*[]string, error) {
+	return request[[]string](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/organization_list", c.baseURL))
+}
+func (c *Client) GetOrganizationContext(ctx context.Context, id string) (*Organization, error) {
+	return request[Organization](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/organization_show?id=%s", c.baseURL, url.QueryEscape(id)))
+}
+func (c *Client) GetTagListContext(ctx context.Context) (*[]string, error) {
+	return request[[]string](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/tag_list", c.baseURL))
+}
+func (c *Client) GetTagShowContext(ctx context.Context, id string) (*Tag, error) {
+	return request[Tag](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/tag_show?id=%s", c.baseURL, url.QueryEscape(id)))
+}
+func (c *Client) GetUserListContext(ctx context.Context) (*[]string, error) {
+	return request[[]string](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/user_list", c.baseURL))
+}
+func (c *Client) GetUserContext(ctx context.Context, id string) (*User, error) {
+	return request[User](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/user_show?id=%s", c.baseURL, url.QueryEscape(id)))
+}
+func (c *Client) GetLicenseListContext(ctx context.Context) (*[]License, error) {
+	return request[[]License](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/license_list", c.baseURL))
+}
+func (c *Client) GetVocabularyListContext(ctx context.Context) (*[]Vocabulary, error) {
+	return request[[]Vocabulary](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/vocabulary_list", c.baseURL))
+}
+func (c *Client) GetVocabularyContext(ctx context.Context, id string) (*Vocabulary, error) {
+	return request[Vocabulary](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/vocabulary_show?id=%s", c.baseURL, url.QueryEscape(id)))
+}
+func (c *Client) GetPackageSearchContext(ctx context.Context, query string, rows, start int) (*PackageSearch, error) {
+	return c.SearchPackages(ctx, PackageSearchOptions{Query: query, Rows: &rows, Start: start})
+}
+func (c *Client) SearchResourceContext(ctx context.Context, query string, limit, offset int) (*ResourceSearch, error) {
+	return request[ResourceSearch](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/resource_search?query=%s&limit=%d&offset=%d", c.baseURL, url.QueryEscape(query), limit, offset))
+}
+func (c *Client) SearchTagContext(ctx context.Context, query string, limit, offset int) (*TagSearch, error) {
+	return request[TagSearch](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/tag_search?query=%s&limit=%d&offset=%d", c.baseURL, url.QueryEscape(query), limit, offset))
 }
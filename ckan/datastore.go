@@ -0,0 +1,340 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"math"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DatastoreField describes a column of a DataStore table.
+type DatastoreField struct {
+	ID   string         `json:"id"`             // The name of the column
+	Type string         `json:"type"`           // The type of the column, e.g., "text", "numeric", "int4", "timestamp"
+	Info map[string]any `json:"info,omitempty"` // Additional information about the column, e.g., its label. Can be nil.
+}
+
+// DatastoreRecord is a row of a DataStore table, keyed by column name.
+// Numbers are decoded as json.Number, so that large integers are not rounded.
+//
+// Use DecodeRecords to convert records to a struct.
+type DatastoreRecord map[string]any
+
+// UnmarshalJSON decodes the record, keeping its numbers as json.Number.
+func (r *DatastoreRecord) UnmarshalJSON(b []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var record map[string]any
+	if err := decoder.Decode(&record); err != nil {
+		return err
+	}
+	*r = record
+	return nil
+}
+
+// DatastoreSearch represents the result of a datastore_search query.
+type DatastoreSearch struct {
+	ResourceID string            `json:"resource_id"` // The ID of the queried resource
+	Fields     []DatastoreField  `json:"fields"`      // The columns of the result
+	Records    []DatastoreRecord `json:"records"`     // The rows of the result
+	Total      int               `json:"total"`       // The total number of rows matching the query
+	Limit      int               `json:"limit"`       // The maximum number of rows returned
+	Offset     int               `json:"offset"`      // The offset of the first returned row
+}
+
+// DatastoreSearchSQL represents the result of a datastore_search_sql query.
+type DatastoreSearchSQL struct {
+	SQL     string            `json:"sql"`     // The executed query
+	Fields  []DatastoreField  `json:"fields"`  // The columns of the result
+	Records []DatastoreRecord `json:"records"` // The rows of the result
+}
+
+// DatastoreSearchOptions are the parameters of a datastore_search query.
+//
+// The zero value returns the first page of rows with the default limit of the instance.
+type DatastoreSearchOptions struct {
+	Filters map[string]any // Exact matches on column values, e.g. {"campus": "Bologna"}
+	Q       string         // A full text query over all the columns
+	Fields  []string       // The columns to return. If empty, all the columns are returned.
+	Sort    string         // A comma separated list of columns with an optional direction, e.g. "anno desc, nome"
+	Limit   int            // The maximum number of rows to return. If zero, the default of the instance is used.
+	Offset  int            // The number of rows to skip
+}
+
+// page returns a copy of the options for the page with the given limit and offset.
+func (o DatastoreSearchOptions) page(limit, offset int) DatastoreSearchOptions {
+	o.Limit, o.Offset = limit, offset
+	return o
+}
+
+func (o DatastoreSearchOptions) values(resourceID string) (url.Values, error) {
+	v := url.Values{}
+	v.Set("resource_id", resourceID)
+	if len(o.Filters) > 0 {
+		filters, err := json.Marshal(o.Filters)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode filters: %w", err)
+		}
+		v.Set("filters", string(filters))
+	}
+	if o.Q != "" {
+		v.Set("q", o.Q)
+	}
+	if len(o.Fields) > 0 {
+		v.Set("fields", strings.Join(o.Fields, ","))
+	}
+	if o.Sort != "" {
+		v.Set("sort", o.Sort)
+	}
+	if o.Limit > 0 {
+		v.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		v.Set("offset", strconv.Itoa(o.Offset))
+	}
+	return v, nil
+}
+
+// DatastoreSearch queries the DataStore table of the given resource
+// (datastore_search). The resource must have DatastoreActive set.
+func (c *Client) DatastoreSearch(ctx context.Context, resourceID string, opts DatastoreSearchOptions) (*DatastoreSearch, error) {
+	v, err := opts.values(resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return request[DatastoreSearch](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/datastore_search?%s", c.baseURL, v.Encode()))
+}
+
+// DatastoreRecords returns an iterator over all the rows matching the query,
+// fetching them one page at a time. opts.Offset is the first row to return,
+// and opts.Limit the size of each page; if zero, the page size of the client
+// is used (see WithPageSize).
+//
+// The iteration stops at the first error, which is yielded with a nil record.
+//
+//	for record, err := range client.DatastoreRecords(ctx, resourceID, opts) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+func (c *Client) DatastoreRecords(ctx context.Context, resourceID string, opts DatastoreSearchOptions) iter.Seq2[DatastoreRecord, error] {
+	pageSize := opts.Limit
+	if pageSize <= 0 {
+		pageSize = c.getPageSize()
+	}
+
+	return paginate(pageSize, opts.Offset, func(limit, offset int) ([]DatastoreRecord, int, error) {
+		page, err := c.DatastoreSearch(ctx, resourceID, opts.page(limit, offset))
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Records, page.Total, nil
+	})
+}
+
+// DatastoreSearchSQL runs a read-only SQL query on the DataStore
+// (datastore_search_sql). Tables are named after the resource IDs:
+//
+//	client.DatastoreSearchSQL(ctx, `SELECT * FROM "`+resourceID+`" WHERE campus = 'Bologna'`)
+func (c *Client) DatastoreSearchSQL(ctx context.Context, sql string) (*DatastoreSearchSQL, error) {
+	v := url.Values{}
+	v.Set("sql", sql)
+	return request[DatastoreSearchSQL](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/datastore_search_sql?%s", c.baseURL, v.Encode()))
+}
+
+// DecodeRecords converts DataStore records to values of type T, which must be a struct.
+//
+// Columns are matched to the exported fields of T by the "datastore" tag, or
+// by name (case-insensitively) if the tag is missing. A tag of "-" skips the
+// field. Columns without a matching field are ignored.
+//
+//	type Degree struct {
+//		Code   string `datastore:"corso_codice"`
+//		Years  int    `datastore:"durata"`
+//	}
+//	degrees, err := ckan.DecodeRecords[Degree](result.Records)
+//
+// Values are converted to the type of the field: numbers and numeric strings
+// to integers and floats, "true"/"false" to booleans, and timestamps to
+// time.Time. Null values leave the field unchanged.
+func DecodeRecords[T any](records []DatastoreRecord) ([]T, error) {
+	out := make([]T, len(records))
+	for i, record := range records {
+		if err := decodeRecord(record, &out[i]); err != nil {
+			return nil, fmt.Errorf("unable to decode record %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+func decodeRecord(record DatastoreRecord, dst any) error {
+	v := reflect.ValueOf(dst).Elem()
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot decode into %s: not a struct", v.Type())
+	}
+
+	// Index the record by lowercase column name, for name-based matching
+	lower := make(map[string]any, len(record))
+	for column, value := range record {
+		lower[strings.ToLower(column)] = value
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		var value any
+		var found bool
+		if tag := field.Tag.Get("datastore"); tag == "-" {
+			continue
+		} else if tag != "" {
+			value, found = record[tag]
+		} else {
+			value, found = lower[strings.ToLower(field.Name)]
+		}
+		if !found || value == nil {
+			continue
+		}
+
+		if err := setValue(v.Field(i), value); err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// timestampLayouts are the layouts used by the DataStore for dates and timestamps.
+var timestampLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02T15:04:05", "2006-01-02"}
+
+func setValue(dst reflect.Value, value any) error {
+	if dst.Type() == reflect.TypeFor[time.Time]() {
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("cannot convert %T to time.Time", value)
+		}
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				dst.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return fmt.Errorf("cannot parse timestamp %q", s)
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		switch value := value.(type) {
+		case string:
+			dst.SetString(value)
+		case json.Number:
+			dst.SetString(value.String())
+		case float64:
+			dst.SetString(strconv.FormatFloat(value, 'f', -1, 64))
+		default:
+			dst.SetString(fmt.Sprint(value))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		var err error
+		switch value := value.(type) {
+		case json.Number:
+			n, err = parseInt(value.String())
+		case float64:
+			n, err = floatToInt(value)
+		case string:
+			n, err = parseInt(strings.TrimSpace(value))
+		default:
+			return fmt.Errorf("cannot convert %T to %s", value, dst.Type())
+		}
+		if err != nil {
+			return err
+		}
+		if dst.OverflowInt(n) {
+			return fmt.Errorf("cannot convert %d to %s: out of range", n, dst.Type())
+		}
+		dst.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		switch value := value.(type) {
+		case json.Number:
+			f, err := value.Float64()
+			if err != nil {
+				return err
+			}
+			dst.SetFloat(f)
+		case float64:
+			dst.SetFloat(value)
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return err
+			}
+			dst.SetFloat(f)
+		default:
+			return fmt.Errorf("cannot convert %T to %s", value, dst.Type())
+		}
+	case reflect.Bool:
+		switch value := value.(type) {
+		case bool:
+			dst.SetBool(value)
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				return err
+			}
+			dst.SetBool(b)
+		default:
+			return fmt.Errorf("cannot convert %T to %s", value, dst.Type())
+		}
+	default:
+		// Fall back to a JSON round trip for other types, e.g. slices and maps
+		b, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(b, dst.Addr().Interface())
+	}
+	return nil
+}
+
+// parseInt parses an integer, also written as a float without a fractional
+// part, e.g. "2.0" or "1e3".
+func parseInt(s string) (int64, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return n, nil
+	}
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil {
+		return 0, err
+	}
+	return floatToInt(f)
+}
+
+// floatToInt converts f to an integer, failing if it has a fractional part
+// instead of truncating it.
+func floatToInt(f float64) (int64, error) {
+	if f != math.Trunc(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("cannot convert %v to an integer", f)
+	}
+	if f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, fmt.Errorf("cannot convert %v to an integer: out of range", f)
+	}
+	return int64(f), nil
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatastoreSearch(t *testing.T) {
+	json := `{"success": true, "result": {"resource_id": "abc", "fields": [{"id": "_id", "type": "int"}, {"id": "corso_codice", "type": "text"}], "records": [{"_id": 1, "corso_codice": "8009"}], "total": 1, "limit": 10}}`
+	url := serveJson(t, `/api/3/action/datastore_search?fields=_id%2Ccorso_codice&filters=%7B%22campus%22%3A%22Bologna%22%7D&limit=10&q=informatica&resource_id=abc&sort=corso_codice+desc`, json)
+
+	result, err := NewClient(url).DatastoreSearch(context.Background(), "abc", DatastoreSearchOptions{
+		Filters: map[string]any{"campus": "Bologna"},
+		Q:       "informatica",
+		Fields:  []string{"_id", "corso_codice"},
+		Sort:    "corso_codice desc",
+		Limit:   10,
+	})
+	require.NoError(t, err, "DatastoreSearch should not return an error")
+
+	assert.Equal(t, 1, result.Total, "Expected total to match")
+	assert.Equal(t, []DatastoreField{{ID: "_id", Type: "int"}, {ID: "corso_codice", Type: "text"}}, result.Fields, "Expected fields to match")
+	assert.Equal(t, "8009", result.Records[0]["corso_codice"], "Expected record to match")
+}
+
+func TestDatastoreRecords(t *testing.T) {
+	const total = 5
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		records := []DatastoreRecord{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			records = append(records, DatastoreRecord{"_id": i})
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"records": records, "total": total}})
+	}))
+	t.Cleanup(srv.Close)
+
+	var ids []json.Number
+	for record, err := range NewClient(srv.URL).DatastoreRecords(context.Background(), "abc", DatastoreSearchOptions{Limit: 2}) {
+		require.NoError(t, err, "DatastoreRecords should not return an error")
+		ids = append(ids, record["_id"].(json.Number))
+	}
+
+	assert.Equal(t, []json.Number{"0", "1", "2", "3", "4"}, ids, "Expected all the records in order")
+	assert.Equal(t, 3, requests, "Expected one request per page")
+
+	// Ranging again must start again from the first page
+	seq := NewClient(srv.URL).DatastoreRecords(context.Background(), "abc", DatastoreSearchOptions{Limit: 2, Offset: 1})
+	for range 2 {
+		ids = nil
+		for record, err := range seq {
+			require.NoError(t, err, "DatastoreRecords should not return an error")
+			ids = append(ids, record["_id"].(json.Number))
+		}
+		assert.Equal(t, []json.Number{"1", "2", "3", "4"}, ids, "Expected the same records when ranging again")
+	}
+
+	// Stopping early must not fetch the following pages
+	requests = 0
+	for range NewClient(srv.URL).DatastoreRecords(context.Background(), "abc", DatastoreSearchOptions{Limit: 2}) {
+		break
+	}
+	assert.Equal(t, 1, requests, "Expected a single request")
+}
+
+func TestDatastoreSearchSQL(t *testing.T) {
+	json := `{"success": true, "result": {"sql": "SELECT 1", "fields": [{"id": "n", "type": "int4"}], "records": [{"n": 1}]}}`
+	url := serveJson(t, "/api/3/action/datastore_search_sql?sql=SELECT+1", json)
+
+	result, err := NewClient(url).DatastoreSearchSQL(context.Background(), "SELECT 1")
+	require.NoError(t, err, "DatastoreSearchSQL should not return an error")
+	assert.Equal(t, "int4", result.Fields[0].Type, "Expected field type to match")
+	assert.Len(t, result.Records, 1, "Expected one record")
+}
+
+func TestDecodeRecords(t *testing.T) {
+	type degree struct {
+		Code          string    `datastore:"corso_codice"`
+		Years         int       `datastore:"durata"`
+		International bool      `datastore:"internazionale"`
+		Fee           float64   `datastore:"tassa"`
+		Updated       time.Time `datastore:"aggiornato"`
+		Campus        string
+		Ignored       string `datastore:"-"`
+	}
+
+	records := []DatastoreRecord{{
+		"corso_codice":   8009.0,
+		"durata":         "3",
+		"internazionale": "false",
+		"tassa":          156.5,
+		"aggiornato":     "2024-09-01T10:00:00",
+		"CAMPUS":         "Bologna",
+		"Ignored":        "x",
+		"unknown":        "y",
+	}}
+
+	degrees, err := DecodeRecords[degree](records)
+	require.NoError(t, err, "DecodeRecords should not return an error")
+	assert.Equal(t, degree{
+		Code:    "8009",
+		Years:   3,
+		Fee:     156.5,
+		Updated: time.Date(2024, time.September, 1, 10, 0, 0, 0, time.UTC),
+		Campus:  "Bologna",
+	}, degrees[0])
+
+	_, err = DecodeRecords[degree]([]DatastoreRecord{{"durata": "tre"}})
+	assert.Error(t, err, "Expected an error for an invalid number")
+
+	_, err = DecodeRecords[degree]([]DatastoreRecord{{"durata": 2.7}})
+	assert.Error(t, err, "Expected an error instead of truncating a float")
+	_, err = DecodeRecords[degree]([]DatastoreRecord{{"durata": json.Number("2.7")}})
+	assert.Error(t, err, "Expected an error instead of truncating a float")
+}
+
+func TestDecodeRecordsLargeIntegers(t *testing.T) {
+	type row struct {
+		ID   int64   `datastore:"_id"`
+		Code string  `datastore:"codice"`
+		Fee  float64 `datastore:"tassa"`
+	}
+
+	var search DatastoreSearch
+	err := json.Unmarshal([]byte(`{"records": [{"_id": 9007199254740993, "codice": 12345678901234567, "tassa": 1.5}]}`), &search)
+	require.NoError(t, err)
+
+	rows, err := DecodeRecords[row](search.Records)
+	require.NoError(t, err, "DecodeRecords should not return an error")
+	assert.Equal(t, row{ID: 9007199254740993, Code: "12345678901234567", Fee: 1.5}, rows[0], "Expected integers not to be rounded through float64")
+}
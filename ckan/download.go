@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrChecksumMismatch is returned when a downloaded resource does not match its Hash.
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrSizeMismatch is returned when a downloaded resource does not match its Size.
+	ErrSizeMismatch = errors.New("size mismatch")
+)
+
+// DownloadOptions configures the download of a resource.
+//
+// The zero value downloads the resource unconditionally, accepting any content type.
+type DownloadOptions struct {
+	// ContentTypes are the accepted media types, e.g. "text/csv". Parameters
+	// such as the charset are ignored. If empty, any content type is accepted.
+	ContentTypes []string
+	// ModifiedSince makes the download conditional: if the resource was not
+	// modified after this time, nothing is downloaded. See Resource.LastModifiedTime.
+	ModifiedSince time.Time
+	// ETag makes the download conditional: if the resource still has this
+	// ETag, nothing is downloaded. See ResourceReader.ETag.
+	ETag string
+	// Progress, if not nil, is called after each chunk is read, with the number
+	// of bytes read so far and the total size, or -1 if unknown.
+	Progress func(read, total int64)
+}
+
+// ResourceReader streams the content of a resource. See Client.OpenResource.
+type ResourceReader struct {
+	ContentType  string    // The media type of the resource, without parameters, e.g. "text/csv"
+	Charset      string    // The charset parameter of the content type, if any, e.g. "utf-8"
+	ETag         string    // The ETag of the resource, if any. It can be used for the next conditional download.
+	LastModified time.Time // The modification time reported by the server, if any
+	Size         int64     // The size of the content, or -1 if unknown
+	NotModified  bool      // Whether the resource was not modified since the conditional download. If true, the reader is empty.
+
+	body     io.ReadCloser
+	read     int64
+	hash     hash.Hash // Nil if the checksum is not verified
+	checksum []byte
+	wantSize int64 // Zero if the size is not verified
+	progress func(read, total int64)
+}
+
+// Read reads the content of the resource.
+//
+// When the end of the content is reached, the size and checksum of the
+// resource are verified, if known: in case of mismatch, Read returns an error
+// wrapping ErrSizeMismatch or ErrChecksumMismatch instead of io.EOF.
+func (r *ResourceReader) Read(p []byte) (int, error) {
+	if r.NotModified {
+		return 0, io.EOF
+	}
+
+	n, err := r.body.Read(p)
+	r.read += int64(n)
+	if r.hash != nil {
+		r.hash.Write(p[:n])
+	}
+	if n > 0 && r.progress != nil {
+		r.progress(r.read, r.Size)
+	}
+
+	if err == io.EOF {
+		if verr := r.verify(); verr != nil {
+			return n, verr
+		}
+	}
+	return n, err
+}
+
+func (r *ResourceReader) verify() error {
+	if r.wantSize > 0 && r.read != r.wantSize {
+		return fmt.Errorf("%w: expected %d bytes, got %d", ErrSizeMismatch, r.wantSize, r.read)
+	}
+	if r.hash != nil {
+		if sum := r.hash.Sum(nil); !slices.Equal(sum, r.checksum) {
+			return fmt.Errorf("%w: expected %x, got %x", ErrChecksumMismatch, r.checksum, sum)
+		}
+	}
+	return nil
+}
+
+// Close closes the underlying response body.
+func (r *ResourceReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	return r.body.Close()
+}
+
+// OpenResource starts the download of the given resource and returns a
+// reader over its content, which must be closed.
+//
+// The checksum of the resource is verified if Resource.Hash is set, either
+// as "algorithm:hex" (md5, sha1, sha256 or sha512) or as plain hex, in which
+// case the algorithm is deduced from its length. The size is verified if
+// Resource.Size is set.
+//
+// The API token set with WithToken is only sent if the resource is hosted by
+// the CKAN instance itself.
+func (c *Client) OpenResource(ctx context.Context, res Resource, opts DownloadOptions) (*ResourceReader, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, res.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request: %w", err)
+	}
+	if !opts.ModifiedSince.IsZero() {
+		req.Header.Set("If-Modified-Since", opts.ModifiedSince.UTC().Format(http.TimeFormat))
+	}
+	if opts.ETag != "" {
+		req.Header.Set("If-None-Match", opts.ETag)
+	}
+
+	var resp *http.Response
+	if c.sameHost(req.URL) {
+		resp, err = c.HTTPClient().Do(req)
+	} else {
+		// Do not leak the headers of the client, e.g. the API token, to other hosts
+		req.Header.Set("User-Agent", c.HTTPClient().UserAgent())
+		resp, err = c.HTTPClient().HTTPClient().Do(req)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get resource: %w", err)
+	}
+
+	r := &ResourceReader{
+		ETag:     resp.Header.Get("ETag"),
+		Size:     resp.ContentLength,
+		body:     resp.Body,
+		progress: opts.Progress,
+	}
+	if lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		r.LastModified = lastModified
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		r.NotModified = true
+		r.Size = 0
+		return r, nil
+	default:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unable to get resource: unexpected status code: %d", resp.StatusCode)
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		mediaType, params, err := mime.ParseMediaType(contentType)
+		if err != nil {
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("unable to parse content type %q: %w", contentType, err)
+		}
+		r.ContentType = mediaType
+		r.Charset = params["charset"]
+	}
+	if len(opts.ContentTypes) > 0 && !slices.Contains(opts.ContentTypes, r.ContentType) {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected content type %q, expected one of %v", r.ContentType, opts.ContentTypes)
+	}
+
+	if r.Size < 0 && res.Size > 0 {
+		r.Size = res.Size
+	}
+	r.wantSize = res.Size
+	r.hash, r.checksum = parseHash(res.Hash)
+
+	return r, nil
+}
+
+// sameHost reports whether u is on the same host as the CKAN instance.
+func (c *Client) sameHost(u *url.URL) bool {
+	base, err := url.Parse(c.baseURL)
+	return err == nil && strings.EqualFold(base.Host, u.Host)
+}
+
+// parseHash returns the hash function and the expected checksum described by
+// a Resource.Hash, or nil if it is empty or not supported.
+func parseHash(s string) (hash.Hash, []byte) {
+	algorithm, hexSum, found := strings.Cut(strings.TrimSpace(s), ":")
+	if !found {
+		algorithm, hexSum = "", algorithm
+	}
+
+	checksum, err := hex.DecodeString(hexSum)
+	if err != nil || len(checksum) == 0 {
+		return nil, nil
+	}
+
+	var h hash.Hash
+	switch strings.ToLower(algorithm) {
+	case "md5":
+		h = md5.New()
+	case "sha1":
+		h = sha1.New()
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	case "":
+		switch len(checksum) {
+		case md5.Size:
+			h = md5.New()
+		case sha1.Size:
+			h = sha1.New()
+		case sha256.Size:
+			h = sha256.New()
+		case sha512.Size:
+			h = sha512.New()
+		}
+	}
+	if h == nil || h.Size() != len(checksum) {
+		return nil, nil
+	}
+	return h, checksum
+}
+
+// Download streams the content of the given resource to w.
+//
+// See OpenResource for how the resource is verified. The returned reader is
+// already closed, and holds the metadata of the download: if NotModified is
+// true, nothing was written.
+func (c *Client) Download(ctx context.Context, res Resource, w io.Writer, opts DownloadOptions) (*ResourceReader, error) {
+	r, err := c.OpenResource(ctx, res, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	_, err = io.Copy(w, r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download resource: %w", err)
+	}
+
+	return r, nil
+}
+
+// DownloadFile downloads the given resource to the file at path.
+//
+// The content is written to a temporary file in the same directory, which
+// replaces path only if the download succeeds. If the resource was not
+// modified (see DownloadOptions.ModifiedSince), the file is left untouched.
+func (c *Client) DownloadFile(ctx context.Context, res Resource, path string, opts DownloadOptions) (*ResourceReader, error) {
+	r, err := c.OpenResource(ctx, res, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	if r.NotModified {
+		return r, nil
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if err != nil {
+		_ = tmp.Close()
+		return nil, fmt.Errorf("unable to download resource: %w", err)
+	}
+
+	// CreateTemp creates the file readable only by its owner, while the
+	// downloaded file should be readable as if created with os.Create
+	err = tmp.Chmod(0o644)
+	if err != nil {
+		_ = tmp.Close()
+		return nil, fmt.Errorf("unable to write file: %w", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return nil, fmt.Errorf("unable to write file: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write file: %w", err)
+	}
+
+	return r, nil
+}
+
+// resourceTimeLayout is the layout of the dates of a Resource, e.g. "2024-09-01T10:00:00.123456".
+const resourceTimeLayout = "2006-01-02T15:04:05.999999"
+
+// LastModifiedTime parses LastModified, which CKAN reports in UTC. It can be
+// used for a conditional download with DownloadOptions.ModifiedSince.
+func (r Resource) LastModifiedTime() (time.Time, error) {
+	return time.Parse(resourceTimeLayout, r.LastModified)
+}
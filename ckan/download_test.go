@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const csvContent = "codice,nome\n8009,Informatica\n"
+
+var lastModified = time.Date(2024, time.September, 1, 10, 0, 0, 0, time.UTC)
+
+// serveResource serves csvContent, honoring conditional requests. It returns
+// the URL of the resource and a pointer to the last Authorization header received.
+func serveResource(t *testing.T) (string, *string) {
+	var authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("ETag", `"v1"`)
+		http.ServeContent(w, r, "corsi.csv", lastModified, bytes.NewReader([]byte(csvContent)))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL + "/corsi.csv", &authorization
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDownload(t *testing.T) {
+	url, _ := serveResource(t)
+	client := NewClient("https://dati.unibo.it")
+
+	var progress []int64
+	var buf bytes.Buffer
+	r, err := client.Download(context.Background(), Resource{URL: url, Hash: sha256Hex(csvContent), Size: int64(len(csvContent))}, &buf, DownloadOptions{
+		ContentTypes: []string{"text/csv"},
+		Progress:     func(read, total int64) { progress = append(progress, read) },
+	})
+	require.NoError(t, err, "Download should not return an error")
+
+	assert.Equal(t, csvContent, buf.String(), "Expected content to match")
+	assert.Equal(t, "text/csv", r.ContentType, "Expected content type without parameters")
+	assert.Equal(t, "utf-8", r.Charset, "Expected charset to match")
+	assert.Equal(t, `"v1"`, r.ETag, "Expected ETag to match")
+	assert.Equal(t, lastModified, r.LastModified.UTC(), "Expected last modified to match")
+	assert.Equal(t, int64(len(csvContent)), progress[len(progress)-1], "Expected progress to reach the size")
+}
+
+func TestDownloadVerification(t *testing.T) {
+	url, _ := serveResource(t)
+	client := NewClient("https://dati.unibo.it")
+
+	var buf bytes.Buffer
+	_, err := client.Download(context.Background(), Resource{URL: url, Hash: "sha256:" + sha256Hex("other")}, &buf, DownloadOptions{})
+	assert.ErrorIs(t, err, ErrChecksumMismatch, "Expected a checksum mismatch")
+
+	_, err = client.Download(context.Background(), Resource{URL: url, Size: 1}, &buf, DownloadOptions{})
+	assert.ErrorIs(t, err, ErrSizeMismatch, "Expected a size mismatch")
+
+	_, err = client.Download(context.Background(), Resource{URL: url, Hash: "not a checksum"}, &buf, DownloadOptions{})
+	assert.NoError(t, err, "Expected unsupported hashes to be ignored")
+
+	_, err = client.Download(context.Background(), Resource{URL: url}, &buf, DownloadOptions{ContentTypes: []string{"application/json"}})
+	assert.Error(t, err, "Expected an unexpected content type error")
+}
+
+func TestDownloadConditional(t *testing.T) {
+	url, _ := serveResource(t)
+	client := NewClient("https://dati.unibo.it")
+
+	var buf bytes.Buffer
+	r, err := client.Download(context.Background(), Resource{URL: url}, &buf, DownloadOptions{ModifiedSince: lastModified})
+	require.NoError(t, err, "Download should not return an error")
+	assert.True(t, r.NotModified, "Expected the resource not to be modified")
+	assert.Zero(t, buf.Len(), "Expected nothing to be written")
+
+	r, err = client.Download(context.Background(), Resource{URL: url}, &buf, DownloadOptions{ETag: `"v1"`})
+	require.NoError(t, err, "Download should not return an error")
+	assert.True(t, r.NotModified, "Expected the resource not to be modified")
+
+	r, err = client.Download(context.Background(), Resource{URL: url}, &buf, DownloadOptions{ModifiedSince: lastModified.Add(-time.Hour)})
+	require.NoError(t, err, "Download should not return an error")
+	assert.False(t, r.NotModified, "Expected the resource to be modified")
+	assert.Equal(t, csvContent, buf.String(), "Expected content to match")
+}
+
+func TestDownloadTokenNotLeaked(t *testing.T) {
+	url, authorization := serveResource(t)
+
+	var buf bytes.Buffer
+	_, err := NewClient("https://dati.unibo.it", WithToken("secret")).Download(context.Background(), Resource{URL: url}, &buf, DownloadOptions{})
+	require.NoError(t, err, "Download should not return an error")
+	assert.Empty(t, *authorization, "Expected the token not to be sent to another host")
+
+	base := url[:len(url)-len("/corsi.csv")]
+	_, err = NewClient(base, WithToken("secret")).Download(context.Background(), Resource{URL: url}, &buf, DownloadOptions{})
+	require.NoError(t, err, "Download should not return an error")
+	assert.Equal(t, "secret", *authorization, "Expected the token to be sent to the CKAN instance")
+}
+
+func TestDownloadFile(t *testing.T) {
+	url, _ := serveResource(t)
+	path := filepath.Join(t.TempDir(), "corsi.csv")
+
+	_, err := NewClient("https://dati.unibo.it").DownloadFile(context.Background(), Resource{URL: url}, path, DownloadOptions{})
+	require.NoError(t, err, "DownloadFile should not return an error")
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err, "Expected the file to exist")
+	assert.Equal(t, csvContent, string(content), "Expected content to match")
+
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o644), info.Mode().Perm(), "Expected the file to be readable by everyone")
+	}
+
+	_, err = NewClient("https://dati.unibo.it").DownloadFile(context.Background(), Resource{URL: url, Size: 1}, path, DownloadOptions{})
+	assert.ErrorIs(t, err, ErrSizeMismatch, "Expected a size mismatch")
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err, "Expected the file to still exist")
+	assert.Equal(t, csvContent, string(content), "Expected a failed download to leave the file untouched")
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "Expected no temporary file to be left")
+}
+
+func TestLastModifiedTime(t *testing.T) {
+	modified, err := Resource{LastModified: "2024-09-01T10:00:00.123456"}.LastModifiedTime()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.September, 1, 10, 0, 0, 123456000, time.UTC), modified)
+}
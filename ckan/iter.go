@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"context"
+	"iter"
+)
+
+// defaultPageSize is the number of items fetched per request by the iterators,
+// unless changed with WithPageSize.
+const defaultPageSize = 100
+
+// WithPageSize returns a copy of the client whose iterators (e.g. AllPackages)
+// fetch n items per request.
+//
+//	for pkg, err := range client.WithPageSize(20).AllPackages(ctx, "corsi") {
+//		...
+//	}
+func (c *Client) WithPageSize(n int) *Client {
+	clone := *c
+	clone.pageSize = n
+	return &clone
+}
+
+func (c *Client) getPageSize() int {
+	if c.pageSize <= 0 {
+		return defaultPageSize
+	}
+	return c.pageSize
+}
+
+// paginate returns an iterator over the items returned by fetch, requesting
+// one page of pageSize items at a time, starting from offset.
+//
+// fetch returns the items of a page and the total number of items, or a
+// negative total if unknown. The iteration ends when all the items have been
+// returned or at the first error, which is yielded with the zero value of T.
+// When the total is known, the pages can be shorter than pageSize (e.g. if
+// the instance caps the number of rows); when it is unknown, the iteration
+// ends with the first short page.
+func paginate[T any](pageSize, offset int, fetch func(limit, offset int) ([]T, int, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		offset := offset // Each iteration starts from the first page
+		for {
+			items, total, err := fetch(pageSize, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			offset += len(items)
+			switch {
+			case len(items) == 0:
+				// No progress can be made, even if the total is known
+				return
+			case total >= 0:
+				if offset >= total {
+					return
+				}
+			case len(items) < pageSize:
+				return
+			}
+		}
+	}
+}
+
+// AllPackages returns an iterator over all the packages matching query
+// (package_search), transparently fetching the following pages.
+//
+// The iteration stops at the first error, which is yielded with a zero Package.
+//
+//	for pkg, err := range client.AllPackages(ctx, "corsi") {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(pkg.Title)
+//	}
+func (c *Client) AllPackages(ctx context.Context, query string) iter.Seq2[Package, error] {
+	return c.AllPackagesWithOptions(ctx, PackageSearchOptions{Query: query})
+}
+
+// AllResources returns an iterator over all the resources matching query
+// (resource_search), e.g. "format:CSV".
+//
+// See AllPackages for more information.
+func (c *Client) AllResources(ctx context.Context, query string) iter.Seq2[Resource, error] {
+	return paginate(c.getPageSize(), 0, func(limit, offset int) ([]Resource, int, error) {
+		page, err := c.SearchResourceContext(ctx, query, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.Count, nil
+	})
+}
+
+// AllTags returns an iterator over all the tags matching query (tag_search).
+//
+// See AllPackages for more information.
+func (c *Client) AllTags(ctx context.Context, query string) iter.Seq2[Tag, error] {
+	return paginate(c.getPageSize(), 0, func(limit, offset int) ([]Tag, int, error) {
+		page, err := c.SearchTagContext(ctx, query, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.Count, nil
+	})
+}
+
+// AllCurrentPackagesWithResources returns an iterator over all the packages
+// of the instance, including their resources (current_package_list_with_resources).
+//
+// See AllPackages for more information.
+func (c *Client) AllCurrentPackagesWithResources(ctx context.Context) iter.Seq2[Package, error] {
+	return paginate(c.getPageSize(), 0, func(limit, offset int) ([]Package, int, error) {
+		page, err := c.GetCurrentPackageListWithResourcesContext(ctx, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		// The total is unknown: the iteration ends with the first short page
+		return *page, -1, nil
+	})
+}
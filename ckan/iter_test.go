@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// servePackages starts a CKAN stand-in with total packages, serving both
+// package_search and current_package_list_with_resources, with at most
+// maxRows packages per page if maxRows is positive. It returns the URL of the
+// server and a pointer to the number of requests received.
+func servePackages(t *testing.T, total, maxRows int) (string, *int) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		query := r.URL.Query()
+		limit, _ := strconv.Atoi(query.Get("rows") + query.Get("limit"))
+		if maxRows > 0 {
+			limit = min(limit, maxRows)
+		}
+		offset, _ := strconv.Atoi(query.Get("start") + query.Get("offset"))
+
+		packages := []Package{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			packages = append(packages, Package{ID: strconv.Itoa(i)})
+		}
+
+		var result any = packages
+		if r.URL.Path == "/api/3/action/package_search" {
+			result = PackageSearch{Count: total, Results: packages}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": result})
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv.URL, &requests
+}
+
+func collectIDs(t *testing.T, seq iter.Seq2[Package, error]) []string {
+	var ids []string
+	for pkg, err := range seq {
+		require.NoError(t, err, "The iterator should not return an error")
+		ids = append(ids, pkg.ID)
+	}
+	return ids
+}
+
+func TestAllPackages(t *testing.T) {
+	url, requests := servePackages(t, 7, 0)
+	client := NewClient(url).WithPageSize(3)
+
+	ids := collectIDs(t, client.AllPackages(context.Background(), "corsi"))
+	assert.Equal(t, []string{"0", "1", "2", "3", "4", "5", "6"}, ids, "Expected all the packages in order")
+	assert.Equal(t, 3, *requests, "Expected one request per page")
+}
+
+func TestAllPackagesExactPages(t *testing.T) {
+	url, requests := servePackages(t, 6, 0)
+
+	ids := collectIDs(t, NewClient(url).WithPageSize(3).AllPackages(context.Background(), ""))
+	assert.Len(t, ids, 6, "Expected all the packages")
+	assert.Equal(t, 2, *requests, "Expected no request after the count is reached")
+}
+
+func TestAllPackagesCappedRows(t *testing.T) {
+	url, requests := servePackages(t, 7, 2)
+
+	ids := collectIDs(t, NewClient(url).WithPageSize(3).AllPackages(context.Background(), ""))
+	assert.Len(t, ids, 7, "Expected all the packages, even if the pages are short")
+	assert.Equal(t, 4, *requests, "Expected the iteration to end when the count is reached")
+}
+
+func TestAllCurrentPackagesWithResources(t *testing.T) {
+	url, requests := servePackages(t, 6, 0)
+
+	ids := collectIDs(t, NewClient(url).WithPageSize(3).AllCurrentPackagesWithResources(context.Background()))
+	assert.Len(t, ids, 6, "Expected all the packages")
+	assert.Equal(t, 3, *requests, "Expected a last empty page, since the total is unknown")
+}
+
+func TestAllPackagesTwice(t *testing.T) {
+	url, _ := servePackages(t, 5, 0)
+	seq := NewClient(url).WithPageSize(2).AllPackagesWithOptions(context.Background(), PackageSearchOptions{Start: 1})
+
+	first := collectIDs(t, seq)
+	assert.Equal(t, []string{"1", "2", "3", "4"}, first)
+	assert.Equal(t, first, collectIDs(t, seq), "Expected the same packages when ranging again")
+}
+
+func TestAllPackagesEarlyTermination(t *testing.T) {
+	url, requests := servePackages(t, 100, 0)
+
+	var n int
+	for range NewClient(url).WithPageSize(10).AllPackages(context.Background(), "") {
+		n++
+		if n == 15 {
+			break
+		}
+	}
+	assert.Equal(t, 2, *requests, "Expected no request after the loop stopped")
+}
+
+func TestAllPackagesError(t *testing.T) {
+	url := serveStatus(t, http.StatusInternalServerError, "")
+
+	var errs []error
+	for _, err := range NewClient(url).AllPackages(context.Background(), "") {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1, "Expected a single error")
+	assert.EqualError(t, errs[0], fmt.Sprintf("API call failed: unexpected status code: %d", http.StatusInternalServerError))
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// PackageSearchOptions are the parameters of a package_search query.
+//
+// All the values are escaped, so they can contain any character.
+type PackageSearchOptions struct {
+	Query          string   // The Solr query (q), e.g. "corsi" or "title:corsi". If empty, all the packages match.
+	FilterQuery    string   // A Solr filter query (fq), e.g. `organization:aform tags:"didattica"`
+	Sort           string   // The sorting of the results, e.g. "metadata_modified desc". If empty, the default of the instance is used.
+	Rows           *int     // The maximum number of packages to return. If nil, the default of the instance is used.
+	Start          int      // The offset of the first package to return
+	FacetFields    []string // The fields to compute facets for, e.g. "tags", "organization", "res_format"
+	FacetLimit     int      // The maximum number of items per facet. If zero, the default of the instance is used; -1 means unlimited.
+	IncludePrivate bool     // Whether to include the private packages the user can access
+	IncludeDrafts  bool     // Whether to include the draft packages the user can access
+}
+
+func (o PackageSearchOptions) values() (url.Values, error) {
+	v := url.Values{}
+	if o.Query != "" {
+		v.Set("q", o.Query)
+	}
+	if o.FilterQuery != "" {
+		v.Set("fq", o.FilterQuery)
+	}
+	if o.Sort != "" {
+		v.Set("sort", o.Sort)
+	}
+	if o.Rows != nil {
+		v.Set("rows", strconv.Itoa(*o.Rows))
+	}
+	if o.Start > 0 {
+		v.Set("start", strconv.Itoa(o.Start))
+	}
+	if len(o.FacetFields) > 0 {
+		fields, err := json.Marshal(o.FacetFields)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode facet fields: %w", err)
+		}
+		v.Set("facet", "true")
+		v.Set("facet.field", string(fields))
+	}
+	if o.FacetLimit != 0 {
+		v.Set("facet.limit", strconv.Itoa(o.FacetLimit))
+	}
+	if o.IncludePrivate {
+		v.Set("include_private", "true")
+	}
+	if o.IncludeDrafts {
+		v.Set("include_drafts", "true")
+	}
+	return v, nil
+}
+
+// SearchPackages searches the packages matching the given options (package_search).
+//
+//	result, err := client.SearchPackages(ctx, ckan.PackageSearchOptions{
+//		Query:       "corsi",
+//		FilterQuery: "organization:aform",
+//		Sort:        "title_string asc",
+//		FacetFields: []string{"tags"},
+//	})
+//	for _, item := range result.SearchFacets["tags"].Items {
+//		fmt.Println(item.DisplayName, item.Count)
+//	}
+func (c *Client) SearchPackages(ctx context.Context, opts PackageSearchOptions) (*PackageSearch, error) {
+	v, err := opts.values()
+	if err != nil {
+		return nil, err
+	}
+	return request[PackageSearch](ctx, c.HTTPClient(), fmt.Sprintf("%s/api/3/action/package_search?%s", c.baseURL, v.Encode()))
+}
+
+// AllPackagesWithOptions is like AllPackages, but with the given search
+// options. opts.Start is the first package to return, and opts.Rows the size
+// of each page; if nil or not positive, the page size of the client is used.
+func (c *Client) AllPackagesWithOptions(ctx context.Context, opts PackageSearchOptions) iter.Seq2[Package, error] {
+	pageSize := c.getPageSize()
+	if opts.Rows != nil && *opts.Rows > 0 {
+		pageSize = *opts.Rows
+	}
+
+	return paginate(pageSize, opts.Start, func(limit, offset int) ([]Package, int, error) {
+		pageOpts := opts
+		pageOpts.Rows, pageOpts.Start = &limit, offset
+		page, err := c.SearchPackages(ctx, pageOpts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return page.Results, page.Count, nil
+	})
+}
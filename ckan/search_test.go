@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ckan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchPackages(t *testing.T) {
+	json := `{"success": true, "result": {"count": 2, "results": [{"id": "1"}, {"id": "2"}], "search_facets": {"tags": {"title": "tags", "items": [{"name": "didattica", "display_name": "Didattica", "count": 2}, {"name": "corsi", "display_name": "corsi", "count": 1}]}}}}`
+	url := serveJson(t, `/api/3/action/package_search?facet=true&facet.field=%5B%22tags%22%5D&facet.limit=-1&fq=organization%3Aaform&include_private=true&q=corsi+%26+lauree&rows=2&sort=title_string+asc&start=4`, json)
+
+	rows := 2
+	result, err := NewClient(url).SearchPackages(context.Background(), PackageSearchOptions{
+		Query:          "corsi & lauree",
+		FilterQuery:    "organization:aform",
+		Sort:           "title_string asc",
+		Rows:           &rows,
+		Start:          4,
+		FacetFields:    []string{"tags"},
+		FacetLimit:     -1,
+		IncludePrivate: true,
+	})
+	require.NoError(t, err, "SearchPackages should not return an error")
+
+	assert.Equal(t, 2, result.Count, "Expected count to match")
+	assert.Len(t, result.Results, 2, "Expected two results")
+	assert.Equal(t, Facet{
+		Title: "tags",
+		Items: []FacetItem{
+			{Name: "didattica", DisplayName: "Didattica", Count: 2},
+			{Name: "corsi", DisplayName: "corsi", Count: 1},
+		},
+	}, result.SearchFacets["tags"], "Expected facet to match")
+}
+
+func TestGetPackageSearchEscapesQuery(t *testing.T) {
+	json := `{"success": true, "result": {"count": 0, "results": [], "search_facets": {}}}`
+	url := serveJson(t, `/api/3/action/package_search?q=name%3Acorsi%26rows%3D1000&rows=10&start=20`, json)
+
+	result, err := NewClient(url).GetPackageSearch("name:corsi&rows=1000", 10, 20)
+	require.NoError(t, err, "GetPackageSearch should not return an error")
+	assert.Equal(t, 0, result.Count, "Expected count to match")
+}
+
+func TestGetPackageSearchZeroRows(t *testing.T) {
+	json := `{"success": true, "result": {"count": 12, "results": [], "search_facets": {}}}`
+	url := serveJson(t, `/api/3/action/package_search?q=corsi&rows=0`, json)
+
+	result, err := NewClient(url).GetPackageSearch("corsi", 0, 0)
+	require.NoError(t, err, "GetPackageSearch should not return an error")
+	assert.Equal(t, 12, result.Count, "Expected only the count, with rows=0")
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package unibo
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// DefaultUserAgent is the User-Agent sent by a Client when none is configured.
+const DefaultUserAgent = "unibo-go (+https://github.com/cartabinaria/unibo-go)"
+
+// Client performs the HTTP requests made by the packages of this module.
+//
+// The zero value is ready to use: it sends requests through http.DefaultClient
+// with the DefaultUserAgent. Use NewClient to customize it.
+//
+// Every package that talks to a UniBo service has its own Client type that
+// wraps this one, e.g.
+//
+//	tt := timetable.NewClient(
+//		unibo.WithTimeout(10*time.Second),
+//		unibo.WithUserAgent("my-bot/1.0"),
+//	)
+//	events, err := tt.FetchTimetable(ctx, "laurea", "IngegneriaInformatica", "", 1, nil)
+type Client struct {
+	httpClient *http.Client  // The underlying HTTP client. If nil, http.DefaultClient is used.
+	userAgent  string        // The User-Agent header. If empty, DefaultUserAgent is used.
+	baseURL    string        // Overrides the base URL of the service, e.g. to point to a test server.
+	header     http.Header   // Additional headers sent with every request
+	timeout    time.Duration // The time limit of every request, set with WithTimeout
+}
+
+// DefaultClient is the Client used by the package-level functions of this
+// module. It can be replaced to change the behavior of every package at once.
+var DefaultClient = &Client{}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to perform requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeader adds a header sent with every request, e.g. an Authorization token.
+//
+// It can be repeated to add multiple headers.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		if c.header == nil {
+			c.header = make(http.Header)
+		}
+		c.header.Add(key, value)
+	}
+}
+
+// WithBaseURL overrides the base URL of the service the client talks to,
+// e.g. "https://corsi.unibo.it" for the timetable package.
+//
+// It is mostly useful to point the library to a local stand-in server.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithTimeout sets a time limit for every request made by the client.
+//
+// The timeout is applied to a copy of the HTTP client, so it can be combined
+// with WithHTTPClient, in any order, without modifying the given client.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// NewClient creates a new Client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	c := &Client{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.timeout != 0 {
+		httpClient := *c.HTTPClient()
+		httpClient.Timeout = c.timeout
+		c.httpClient = &httpClient
+	}
+	return c
+}
+
+// HTTPClient returns the HTTP client used to perform requests.
+func (c *Client) HTTPClient() *http.Client {
+	if c == nil || c.httpClient == nil {
+		return http.DefaultClient
+	}
+	return c.httpClient
+}
+
+// UserAgent returns the User-Agent header sent with every request.
+func (c *Client) UserAgent() string {
+	if c == nil || c.userAgent == "" {
+		return DefaultUserAgent
+	}
+	return c.userAgent
+}
+
+// BaseURL returns the base URL set with WithBaseURL, or fallback if none was set.
+func (c *Client) BaseURL(fallback string) string {
+	if c == nil || c.baseURL == "" {
+		return fallback
+	}
+	return c.baseURL
+}
+
+// Do sends the given request, setting the User-Agent header and the headers
+// added with WithHeader if the request does not have them already.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("User-Agent") == "" {
+		req.Header.Set("User-Agent", c.UserAgent())
+	}
+	if c != nil {
+		for key, values := range c.header {
+			if req.Header.Get(key) == "" {
+				// Cloned, so that changing the header of a request does not
+				// change the ones of the others
+				req.Header[key] = slices.Clone(values)
+			}
+		}
+	}
+	return c.HTTPClient().Do(req)
+}
+
+// Get issues a GET request to the given url, bound to ctx.
+func (c *Client) Get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req)
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package unibo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientUserAgent(t *testing.T) {
+	var userAgent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+	}))
+	t.Cleanup(srv.Close)
+
+	res, err := NewClient().Get(context.Background(), srv.URL)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, DefaultUserAgent, userAgent)
+
+	res, err = NewClient(WithUserAgent("test/1.0")).Get(context.Background(), srv.URL)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, "test/1.0", userAgent)
+}
+
+func TestClientOptions(t *testing.T) {
+	httpClient := &http.Client{}
+	c := NewClient(WithHTTPClient(httpClient), WithTimeout(time.Second), WithBaseURL("http://localhost"))
+
+	assert.Equal(t, time.Second, c.HTTPClient().Timeout)
+	assert.Zero(t, httpClient.Timeout, "WithTimeout should not modify the given client")
+	assert.Equal(t, "http://localhost", c.BaseURL("https://corsi.unibo.it"))
+	assert.Equal(t, "https://corsi.unibo.it", NewClient().BaseURL("https://corsi.unibo.it"))
+
+	c = NewClient(WithTimeout(time.Second), WithHTTPClient(httpClient))
+	assert.Equal(t, time.Second, c.HTTPClient().Timeout, "WithTimeout should not depend on the order of the options")
+	assert.Zero(t, httpClient.Timeout, "WithTimeout should not modify the given client")
+}
+
+func TestClientHeader(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(srv.Close)
+
+	c := NewClient(WithHeader("X-Token", "secret"))
+	for range 2 {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+		res, err := c.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+
+		assert.Equal(t, []string{"secret"}, req.Header["X-Token"])
+		req.Header["X-Token"][0] = "changed"
+		req.Header.Add("X-Token", "other")
+	}
+	assert.Equal(t, []string{"secret"}, c.header["X-Token"], "the headers of a request should not change the ones of the client")
+}
+
+func TestClientContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := NewClient().Get(ctx, srv.URL)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cartabinaria/unibo-go/conflicts"
+	"github.com/cartabinaria/unibo-go/exams"
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+var cmdConflicts = &cobra.Command{
+	Use:   "conflicts courseType/courseId/year[/curriculum]...",
+	Short: "finds overlapping lessons and exams in a study plan",
+	Long: `finds overlapping lessons and exams in a study plan.
+Each argument is a timetable to check, made of the courseType and courseId
+(see "unibo timetable"), the year and optionally the curriculum.
+The teachings of the study plan can be selected with --subject.`,
+	Example: "unibo timetable conflicts laurea/informatica/2 laurea/informatica/3 -s 72677 -s 00819 --exams",
+	Args:    cobra.MinimumNArgs(1),
+	Run:     runConflicts,
+}
+
+var (
+	conflictsSubjects     []string
+	conflictsDays         int
+	conflictsExams        bool
+	conflictsExamDuration time.Duration
+)
+
+func init() {
+	cmdTimetable.AddCommand(cmdConflicts)
+	cmdConflicts.Flags().StringSliceVarP(&conflictsSubjects, "subject", "s", nil, "code of a teaching of the study plan, can be repeated (default all)")
+	cmdConflicts.Flags().IntVarP(&conflictsDays, "days", "d", 7, "number of days to check, starting from today")
+	cmdConflicts.Flags().BoolVar(&conflictsExams, "exams", false, "check the exams of the courses too")
+	cmdConflicts.Flags().DurationVar(&conflictsExamDuration, "exam-duration", 2*time.Hour, "expected duration of each exam")
+}
+
+// timetableArg is a timetable to check, parsed from an argument.
+type timetableArg struct {
+	courseType, courseId, curriculum string
+	year                             int
+}
+
+func parseTimetableArg(arg string) (timetableArg, error) {
+	parts := strings.Split(arg, "/")
+	if len(parts) < 3 || len(parts) > 4 {
+		return timetableArg{}, fmt.Errorf("invalid timetable %q: expected courseType/courseId/year[/curriculum]", arg)
+	}
+
+	year, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return timetableArg{}, fmt.Errorf("invalid timetable %q: year must be a number", arg)
+	}
+
+	t := timetableArg{courseType: parts[0], courseId: parts[1], year: year}
+	if len(parts) == 4 {
+		t.curriculum = parts[3]
+	}
+	return t, nil
+}
+
+func runConflicts(cmd *cobra.Command, args []string) {
+	if conflictsDays < 1 {
+		Errorln("days must be at least 1")
+		return
+	}
+
+	var targets []timetableArg
+	for _, arg := range args {
+		t, err := parseTimetableArg(arg)
+		if err != nil {
+			Errorln(err)
+			return
+		}
+		targets = append(targets, t)
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	interval := &timetable.Interval{Start: today, End: today.AddDate(0, 0, conflictsDays-1)}
+
+	var items []conflicts.Item
+	fetchedExams := make(map[string]bool)
+	for _, t := range targets {
+		tt, err := timetable.FetchTimetableContext(cmd.Context(), t.courseType, t.courseId, t.curriculum, t.year, interval)
+		if err != nil {
+			Errorf("error fetching timetable: %v\n", err)
+			return
+		}
+		if len(conflictsSubjects) > 0 {
+			tt = slices.DeleteFunc(tt, func(e timetable.Event) bool { return !slices.ContainsFunc(conflictsSubjects, e.HasModule) })
+		}
+		items = append(items, conflicts.Lessons(tt)...)
+
+		course := t.courseType + "/" + t.courseId
+		if !conflictsExams || fetchedExams[course] {
+			continue
+		}
+		fetchedExams[course] = true
+
+		e, err := exams.GetExamsContext(cmd.Context(), t.courseType, t.courseId)
+		if err != nil {
+			Errorf("error fetching exams: %v\n", err)
+			return
+		}
+		if len(conflictsSubjects) > 0 {
+			e = slices.DeleteFunc(e, func(e exams.Exam) bool { return !slices.Contains(conflictsSubjects, e.SubjectCode) })
+		}
+		items = append(items, conflicts.Exams(e, conflictsExamDuration)...)
+	}
+
+	found := conflicts.Find(items)
+	if len(found) == 0 {
+		fmt.Println(greenFmt("No conflicts found"))
+		return
+	}
+
+	for _, c := range found {
+		fmt.Printf("- %s %s -> %s (%s): %s %s\n    overlaps %s %s\n",
+			c.Start.Format("Mon 02/01"), greenFmt(c.Start.Format("15:04")), redFmt(c.End.Format("15:04")),
+			yellowFmt(c.Overlap), describeItem(c.A), grayFmt(c.A.Code),
+			describeItem(c.B), grayFmt(c.B.Code))
+	}
+}
+
+func describeItem(item conflicts.Item) string {
+	if item.Kind == conflicts.KindExam {
+		return "exam " + item.Title
+	}
+	return item.Title
+}
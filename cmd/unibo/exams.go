@@ -36,17 +36,23 @@ courseId = ingegneriainformatica`,
 	Run: runExams,
 }
 
-var outputFmt string
+var (
+	outputFmt     string
+	examDuration  time.Duration
+	examReminders []time.Duration
+)
 
 func init() {
 	rootCmd.AddCommand(examsCmd)
-	examsCmd.Flags().StringVarP(&outputFmt, "format", "f", "human", "output format (human, csv, json)")
+	examsCmd.Flags().StringVarP(&outputFmt, "format", "f", "human", "output format (human, csv, json, ics)")
+	examsCmd.Flags().DurationVar(&examDuration, "duration", 2*time.Hour, "expected duration of each exam (ics only)")
+	examsCmd.Flags().DurationSliceVar(&examReminders, "reminder", nil, "add a reminder this long before each exam, can be repeated (ics only)")
 }
 
 var contacts = ccache.New(ccache.Configure[[]rubrica.Contact]().MaxSize(1000))
 
 func runExams(cmd *cobra.Command, args []string) {
-	if outputFmt != "human" && outputFmt != "csv" && outputFmt != "json" {
+	if outputFmt != "human" && outputFmt != "csv" && outputFmt != "json" && outputFmt != "ics" {
 		Errorln("invalid output format:", outputFmt)
 		return
 	}
@@ -62,7 +68,7 @@ func runExams(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	e, err := exams.GetExams(args[0], args[1])
+	e, err := exams.GetExamsContext(cmd.Context(), args[0], args[1])
 	if err != nil {
 		cmd.PrintErrln(err)
 		return
@@ -174,6 +180,11 @@ func runExams(cmd *cobra.Command, args []string) {
 		if err := encoder.Encode(entries); err != nil {
 			cmd.PrintErrln(err)
 		}
+	case "ics":
+		opts := exams.CalendarOptions{Duration: examDuration, Reminders: examReminders}
+		if err := exams.WriteICS(cmd.OutOrStdout(), e, opts); err != nil {
+			cmd.PrintErrln(err)
+		}
 	}
 }
 
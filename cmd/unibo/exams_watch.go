@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/cartabinaria/unibo-go/exams"
+	"github.com/cartabinaria/unibo-go/snapshot"
+)
+
+var cmdExamsWatch = &cobra.Command{
+	Use:   "watch courseType courseId [subjectRegex]",
+	Short: "reports the new, changed and removed exams since the last run",
+	Long: `reports the new, changed and removed exams since the last run.
+At each run the exams of the degree are fetched, compared with the ones saved
+by the previous run, and saved again. An exam moved to another date is
+reported as removed and added.
+With --webhook, the changes are also sent as JSON in a POST request; if the
+request fails, the exams are not saved, so the changes are sent again by the
+next run.`,
+	Example: "unibo exams watch laurea informatica --webhook https://example.com/hook",
+	Args:    cobra.RangeArgs(2, 3),
+	Run:     runExamsWatch,
+}
+
+var (
+	examsWatchFmt     string
+	examsWatchDir     string
+	examsWatchWebhook string
+)
+
+func init() {
+	examsCmd.AddCommand(cmdExamsWatch)
+	cmdExamsWatch.Flags().StringVarP(&examsWatchFmt, "format", "f", "text", "output format (text, json)")
+	cmdExamsWatch.Flags().StringVar(&examsWatchDir, "dir", "", "directory of the snapshots (default in the user cache directory)")
+	cmdExamsWatch.Flags().StringVar(&examsWatchWebhook, "webhook", "", "URL to POST the changes to, if any")
+}
+
+// examsReport is the JSON output of the command, and the body of the webhook.
+type examsReport struct {
+	Course   string             `json:"course"`
+	Added    []examEntry        `json:"added"`
+	Removed  []examEntry        `json:"removed"`
+	Modified []examModification `json:"modified"`
+}
+
+// examEntry is an exam in an examsReport.
+type examEntry struct {
+	SubjectCode   string    `json:"subject_code"`
+	SubjectName   string    `json:"subject_name"`
+	Teacher       string    `json:"teacher"`
+	Date          time.Time `json:"date"`
+	Type          string    `json:"type"`
+	Location      string    `json:"location"`
+	Subscriptions string    `json:"subscriptions"`
+}
+
+// examModification is a changed exam in an examsReport.
+type examModification struct {
+	Old    examEntry     `json:"old"`
+	New    examEntry     `json:"new"`
+	Fields []exams.Field `json:"fields"`
+}
+
+func newExamEntry(e exams.Exam) examEntry {
+	return examEntry{
+		SubjectCode:   e.SubjectCode,
+		SubjectName:   e.SubjectName,
+		Teacher:       e.Teacher,
+		Date:          e.Date,
+		Type:          e.Type,
+		Location:      e.Location,
+		Subscriptions: e.Subscriptions,
+	}
+}
+
+func newExamsReport(course string, changes exams.Changes) examsReport {
+	report := examsReport{
+		Course:   course,
+		Added:    make([]examEntry, 0, len(changes.Added)),
+		Removed:  make([]examEntry, 0, len(changes.Removed)),
+		Modified: make([]examModification, 0, len(changes.Modified)),
+	}
+	for _, e := range changes.Added {
+		report.Added = append(report.Added, newExamEntry(e))
+	}
+	for _, e := range changes.Removed {
+		report.Removed = append(report.Removed, newExamEntry(e))
+	}
+	for _, m := range changes.Modified {
+		report.Modified = append(report.Modified, examModification{Old: newExamEntry(m.Old), New: newExamEntry(m.New), Fields: m.Fields})
+	}
+	return report
+}
+
+func runExamsWatch(cmd *cobra.Command, args []string) {
+	if examsWatchFmt != "text" && examsWatchFmt != "json" {
+		Errorln("invalid output format:", examsWatchFmt)
+		return
+	}
+
+	var subjectRegex *regexp.Regexp
+	if len(args) == 3 {
+		var err error
+		subjectRegex, err = regexp.Compile("(?i)" + args[2])
+		if err != nil {
+			Errorln(err)
+			return
+		}
+	}
+	filter := func(e []exams.Exam) []exams.Exam {
+		if subjectRegex == nil {
+			return e
+		}
+		return slices.DeleteFunc(slices.Clone(e), func(exam exams.Exam) bool {
+			return !subjectRegex.MatchString(exam.SubjectName)
+		})
+	}
+
+	dir := examsWatchDir
+	if dir == "" {
+		var err error
+		dir, err = snapshot.DefaultDir("exams")
+		if err != nil {
+			Errorln(err)
+			return
+		}
+	}
+	store := snapshot.NewStore[[]exams.Exam](dir)
+	course := args[0] + "/" + args[1]
+
+	e, err := exams.GetExamsContext(cmd.Context(), args[0], args[1])
+	if err != nil {
+		Errorf("error fetching exams: %v\n", err)
+		return
+	}
+
+	old, found, err := store.Load(course)
+	if err != nil {
+		Errorf("error loading snapshot: %v\n", err)
+		return
+	}
+
+	// The whole course is saved, so that a different subjectRegex can be
+	// used in the next run
+	save := func() {
+		if err := store.Save(course, e); err != nil {
+			Errorf("error saving snapshot: %v\n", err)
+		}
+	}
+
+	if !found {
+		save()
+		if examsWatchFmt == "text" {
+			fmt.Printf("%s: first run, %d exams saved\n", course, len(e))
+		}
+		return
+	}
+
+	changes := exams.Diff(filter(old), filter(e))
+	report := newExamsReport(course, changes)
+
+	switch examsWatchFmt {
+	case "text":
+		printExamChanges(course, changes)
+	case "json":
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			Errorln(err)
+			return
+		}
+	}
+
+	// The snapshot is not saved if the webhook fails, so that the changes
+	// are sent again by the next run
+	if examsWatchWebhook != "" && !changes.IsEmpty() {
+		if err := postWebhook(cmd.Context(), examsWatchWebhook, report); err != nil {
+			Errorf("error sending webhook: %v\n", err)
+			return
+		}
+	}
+
+	save()
+}
+
+func printExamChanges(course string, changes exams.Changes) {
+	if changes.IsEmpty() {
+		fmt.Printf("%s: %s\n", course, greenFmt("no changes"))
+		return
+	}
+
+	formatExam := func(e exams.Exam) string {
+		return fmt.Sprintf("%s %s %s %s", e.Date.Format("Mon 02/01/2006 15:04"), e.SubjectName, grayFmt(e.Type), grayFmt(e.Location))
+	}
+
+	fmt.Printf("%s:\n", course)
+	for _, e := range changes.Added {
+		fmt.Printf("  %s %s\n", greenFmt("+"), formatExam(e))
+	}
+	for _, e := range changes.Removed {
+		fmt.Printf("  %s %s\n", redFmt("-"), formatExam(e))
+	}
+	for _, m := range changes.Modified {
+		var fields []string
+		for _, f := range m.Fields {
+			fields = append(fields, string(f))
+		}
+		fmt.Printf("  %s %s (%s)\n", yellowFmt("~"), formatExam(m.New), yellowFmt(strings.Join(fields, ", ")))
+	}
+}
+
+// postWebhook sends value as JSON in a POST request to url.
+func postWebhook(ctx context.Context, url string, value any) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := unibo.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+	return nil
+}
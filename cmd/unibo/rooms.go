@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cartabinaria/unibo-go/degree"
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+var cmdRooms = &cobra.Command{
+	Use:   "rooms",
+	Short: "finds information about the classrooms",
+}
+
+var cmdRoomsFree = &cobra.Command{
+	Use:   "free courseType/courseId/year[/curriculum]...",
+	Short: "finds the classrooms with no lessons in an interval",
+	Long: `finds the classrooms with no lessons in an interval.
+The classrooms and their lessons are taken from the timetables given as
+arguments (see "unibo timetable conflicts"), over the week of the day: add
+the timetables of all the degrees using a building to get reliable results.`,
+	Example: "unibo rooms free laurea/informatica/1 laurea/informatica/2 laurea/informatica/3 -b irnerio --from 14:00 --to 16:00 -n 50",
+	Args:    cobra.MinimumNArgs(1),
+	Run:     runRoomsFree,
+}
+
+var (
+	roomsBuilding string
+	roomsDate     string
+	roomsFrom     string
+	roomsTo       string
+	roomsSeats    int
+)
+
+func init() {
+	rootCmd.AddCommand(cmdRooms)
+	cmdRooms.AddCommand(cmdRoomsFree)
+	cmdRoomsFree.Flags().StringVarP(&roomsBuilding, "building", "b", "", "code, name or address of the building (default all)")
+	cmdRoomsFree.Flags().StringVar(&roomsDate, "date", "", "day to check, as YYYY-MM-DD (default today)")
+	cmdRoomsFree.Flags().StringVar(&roomsFrom, "from", "08:00", "start of the interval, as HH:MM")
+	cmdRoomsFree.Flags().StringVar(&roomsTo, "to", "19:00", "end of the interval, as HH:MM")
+	cmdRoomsFree.Flags().IntVarP(&roomsSeats, "seats", "n", 0, "minimum number of seats")
+}
+
+// parseClock returns the given time of the day, as HH:MM, on day.
+func parseClock(day time.Time, clock string) (time.Time, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected HH:MM", clock)
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, day.Location()), nil
+}
+
+func runRoomsFree(cmd *cobra.Command, args []string) {
+	loc, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		loc = time.Local
+	}
+
+	day := time.Now().In(loc)
+	if roomsDate != "" {
+		day, err = time.ParseInLocation("2006-01-02", roomsDate, loc)
+		if err != nil {
+			Errorf("invalid date %q: expected YYYY-MM-DD\n", roomsDate)
+			return
+		}
+	}
+
+	from, err := parseClock(day, roomsFrom)
+	if err != nil {
+		Errorln(err)
+		return
+	}
+	to, err := parseClock(day, roomsTo)
+	if err != nil {
+		Errorln(err)
+		return
+	}
+	if !to.After(from) {
+		Errorln("the end of the interval must be after its start")
+		return
+	}
+
+	var plan degree.StudyPlan
+	for _, arg := range args {
+		t, err := parseTimetableArg(arg)
+		if err != nil {
+			Errorln(err)
+			return
+		}
+		plan.Entries = append(plan.Entries, degree.PlanEntry{
+			Degree:     degree.ID{Type: t.courseType, Id: t.courseId},
+			Year:       t.year,
+			Curriculum: t.curriculum,
+		})
+	}
+
+	// The rooms are known only if they have lessons in the fetched timetables,
+	// so the whole week is fetched: a room free all day is still listed
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	monday := midnight.AddDate(0, 0, -(int(midnight.Weekday())+6)%7)
+	interval := &timetable.Interval{Start: monday, End: monday.AddDate(0, 0, 6)}
+	tt, err := plan.FetchTimetable(cmd.Context(), interval, degree.DefaultConcurrency)
+	if err != nil {
+		Errorf("error fetching timetables: %v\n", err)
+		return
+	}
+
+	index := timetable.NewRoomIndex(tt)
+	free := index.Free(timetable.RoomQuery{Building: roomsBuilding, From: from, To: to, MinSeats: roomsSeats})
+	if len(free) == 0 {
+		fmt.Println(yellowFmt("No free rooms found"))
+		return
+	}
+
+	for _, r := range free {
+		fmt.Printf("- %s %s (%s seats)\n    %s\n", greenFmt(r.Name), grayFmt(r.Floor), yellowFmt(r.Seats), r.Address)
+	}
+}
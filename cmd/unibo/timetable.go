@@ -36,11 +36,28 @@ courseId = ingegneriainformatica`,
 	Run: runTimetable,
 }
 
+var (
+	timetableFmt   string
+	timetableDays  int
+	timetableChunk int
+)
+
 func init() {
 	rootCmd.AddCommand(cmdTimetable)
+	cmdTimetable.Flags().StringVarP(&timetableFmt, "format", "f", "human", "output format (human, ics)")
+	cmdTimetable.Flags().IntVarP(&timetableDays, "days", "d", 1, "number of days to fetch, starting from today")
+	cmdTimetable.Flags().IntVar(&timetableChunk, "chunk", 0, "number of days fetched by each request, for long intervals (default a single request)")
 }
 
 func runTimetable(cmd *cobra.Command, args []string) {
+	if timetableFmt != "human" && timetableFmt != "ics" {
+		Errorln("invalid output format:", timetableFmt)
+		return
+	}
+	if timetableDays < 1 {
+		Errorln("days must be at least 1")
+		return
+	}
 
 	courseType := args[0]
 	courseId := args[1]
@@ -62,13 +79,33 @@ func runTimetable(cmd *cobra.Command, args []string) {
 
 	today := time.Now().Truncate(24 * time.Hour)
 
-	interval := &timetable.Interval{Start: today, End: today}
-	tt, err := timetable.FetchTimetable(courseType, courseId, curriculum, year, interval)
+	interval := &timetable.Interval{Start: today, End: today.AddDate(0, 0, timetableDays-1)}
+	var tt timetable.Timetable
+	if timetableChunk > 0 {
+		tt, err = timetable.FetchTimetableChunkedContext(cmd.Context(), courseType, courseId, curriculum, year, *interval, timetable.ChunkOptions{
+			WindowDays: timetableChunk,
+			Progress: func(done, total int) {
+				fmt.Fprintf(cmd.ErrOrStderr(), "\rfetched %d/%d", done, total)
+				if done == total {
+					fmt.Fprintln(cmd.ErrOrStderr())
+				}
+			},
+		})
+	} else {
+		tt, err = timetable.FetchTimetableContext(cmd.Context(), courseType, courseId, curriculum, year, interval)
+	}
 	if err != nil {
 		Errorf("error fetching timetable: %v\n", err)
 		return
 	}
 
+	if timetableFmt == "ics" {
+		if err := tt.WriteICS(cmd.OutOrStdout()); err != nil {
+			Errorf("error writing calendar: %v\n", err)
+		}
+		return
+	}
+
 	if len(tt) == 0 {
 		fmt.Println(yellowFmt("No lessons found\n"))
 		return
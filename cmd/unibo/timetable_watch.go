@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cartabinaria/unibo-go/snapshot"
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+var cmdTimetableWatch = &cobra.Command{
+	Use:   "watch courseType/courseId/year[/curriculum]...",
+	Short: "reports the changes of timetables since the last run",
+	Long: `reports the changes of timetables since the last run.
+Each argument is a timetable to watch (see "unibo timetable conflicts").
+At each run the timetables are fetched, compared with the ones saved by the
+previous run, and saved again. Only the days fetched by both runs are compared.`,
+	Example: "unibo timetable watch laurea/informatica/2 -d 14",
+	Args:    cobra.MinimumNArgs(1),
+	Run:     runTimetableWatch,
+}
+
+var (
+	watchDays int
+	watchDir  string
+)
+
+func init() {
+	cmdTimetable.AddCommand(cmdTimetableWatch)
+	cmdTimetableWatch.Flags().IntVarP(&watchDays, "days", "d", 14, "number of days to watch, starting from today")
+	cmdTimetableWatch.Flags().StringVar(&watchDir, "dir", "", "directory of the snapshots (default in the user cache directory)")
+}
+
+// timetableSnapshot is a timetable saved by a previous run.
+type timetableSnapshot struct {
+	Interval  timetable.Interval
+	Timetable timetable.Timetable
+}
+
+// between returns the events starting from the first day to the last day of
+// the interval, both included.
+func between(t timetable.Timetable, interval timetable.Interval) timetable.Timetable {
+	end := interval.End.AddDate(0, 0, 1)
+	return slices.DeleteFunc(slices.Clone(t), func(e timetable.Event) bool {
+		return e.Start.Before(interval.Start) || !e.Start.Before(end)
+	})
+}
+
+func runTimetableWatch(cmd *cobra.Command, args []string) {
+	if watchDays < 1 {
+		Errorln("days must be at least 1")
+		return
+	}
+
+	dir := watchDir
+	if dir == "" {
+		var err error
+		dir, err = snapshot.DefaultDir("timetable")
+		if err != nil {
+			Errorln(err)
+			return
+		}
+	}
+	store := snapshot.NewStore[timetableSnapshot](dir)
+
+	today := time.Now().Truncate(24 * time.Hour)
+	interval := timetable.Interval{Start: today, End: today.AddDate(0, 0, watchDays-1)}
+
+	for _, arg := range args {
+		t, err := parseTimetableArg(arg)
+		if err != nil {
+			Errorln(err)
+			return
+		}
+
+		tt, err := timetable.FetchTimetableContext(cmd.Context(), t.courseType, t.courseId, t.curriculum, t.year, &interval)
+		if err != nil {
+			Errorf("error fetching timetable %s: %v\n", arg, err)
+			continue
+		}
+
+		old, found, err := store.Load(arg)
+		if err != nil {
+			Errorf("error loading snapshot of %s: %v\n", arg, err)
+			continue
+		}
+
+		if !found {
+			fmt.Printf("%s: first run, %d lessons saved\n", arg, len(tt))
+		} else {
+			common := old.Interval
+			if interval.Start.After(common.Start) {
+				common.Start = interval.Start
+			}
+			if interval.End.Before(common.End) {
+				common.End = interval.End
+			}
+			printChanges(arg, timetable.Diff(between(old.Timetable, common), between(tt, common)))
+		}
+
+		err = store.Save(arg, timetableSnapshot{Interval: interval, Timetable: tt})
+		if err != nil {
+			Errorf("error saving snapshot of %s: %v\n", arg, err)
+		}
+	}
+}
+
+func printChanges(name string, changes timetable.Changes) {
+	if changes.IsEmpty() {
+		fmt.Printf("%s: %s\n", name, greenFmt("no changes"))
+		return
+	}
+
+	fmt.Printf("%s:\n", name)
+	for _, e := range changes.Added {
+		fmt.Printf("  %s %s %s %s\n", greenFmt("+"), formatLesson(e), e.Title, grayFmt(e.CodModulo))
+	}
+	for _, e := range changes.Removed {
+		fmt.Printf("  %s %s %s %s\n", redFmt("-"), formatLesson(e), e.Title, grayFmt(e.CodModulo))
+	}
+	for _, m := range changes.Modified {
+		var fields []string
+		for _, f := range m.Fields {
+			fields = append(fields, string(f))
+		}
+		fmt.Printf("  %s %s %s %s (%s)\n", yellowFmt("~"), formatLesson(m.New), m.New.Title,
+			grayFmt(m.New.CodModulo), yellowFmt(strings.Join(fields, ", ")))
+		if slices.Contains(m.Fields, timetable.FieldTime) {
+			fmt.Printf("      was %s\n", formatLesson(m.Old))
+		}
+	}
+}
+
+func formatLesson(e timetable.Event) string {
+	return fmt.Sprintf("%s %s-%s", e.Start.Format("Mon 02/01"), e.Start.Format("15:04"), e.End.Format("15:04"))
+}
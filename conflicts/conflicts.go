@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+// Package conflicts finds overlapping lessons and exams, e.g. for students
+// following teachings from different years or curricula.
+//
+//	items := append(conflicts.Lessons(first, second), conflicts.Exams(e, 2*time.Hour)...)
+//	for _, c := range conflicts.Find(items) {
+//		fmt.Println(c.A.Title, "overlaps", c.B.Title, "for", c.Overlap)
+//	}
+package conflicts
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/cartabinaria/unibo-go/exams"
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+// Kind is the kind of an Item.
+type Kind string
+
+const (
+	KindLesson Kind = "lesson"
+	KindExam   Kind = "exam"
+)
+
+// Item is a lesson or an exam, with the time it takes.
+type Item struct {
+	Kind  Kind
+	Code  string // The code of the teaching, e.g. timetable.Event.CodModulo or exams.Exam.SubjectCode
+	Title string
+	Start time.Time
+	End   time.Time
+
+	Lesson *timetable.Event // The lesson, if Kind is KindLesson
+	Exam   *exams.Exam      // The exam, if Kind is KindExam
+}
+
+// Conflict is a pair of overlapping items. A starts before or with B.
+type Conflict struct {
+	A, B    Item
+	Start   time.Time     // The start of the overlap
+	End     time.Time     // The end of the overlap
+	Overlap time.Duration // The duration of the overlap
+}
+
+// Lessons converts the events of the timetables to items.
+func Lessons(timetables ...timetable.Timetable) []Item {
+	var items []Item
+	for _, t := range timetables {
+		for i := range t {
+			e := &t[i]
+			items = append(items, Item{
+				Kind:   KindLesson,
+				Code:   e.CodModulo,
+				Title:  e.Title,
+				Start:  e.Start.Time,
+				End:    e.End.Time,
+				Lesson: e,
+			})
+		}
+	}
+	return items
+}
+
+// Exams converts the exams to items lasting duration, since the website does
+// not tell how long an exam takes.
+func Exams(list []exams.Exam, duration time.Duration) []Item {
+	items := make([]Item, 0, len(list))
+	for i := range list {
+		e := &list[i]
+		items = append(items, Item{
+			Kind:  KindExam,
+			Code:  e.SubjectCode,
+			Title: e.SubjectName,
+			Start: e.Date,
+			End:   e.Date.Add(duration),
+			Exam:  e,
+		})
+	}
+	return items
+}
+
+// Find returns the pairs of overlapping items, sorted by the start of the
+// overlap. Items that only touch (one ends when the other starts) do not
+// overlap.
+//
+// Items with the same code are never reported, since they belong to the same
+// teaching, e.g. the lessons of the groups A-K and L-Z of a teaching. Duplicated
+// items, e.g. the same lesson listed in two timetables, are considered once.
+func Find(items []Item) []Conflict {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b Item) int { return a.Start.Compare(b.Start) })
+
+	var conflicts []Conflict
+	var active []Item // The items that have not ended yet
+	for _, item := range sorted {
+		// Drop the items ended before this one starts
+		active = slices.DeleteFunc(active, func(a Item) bool { return !a.End.After(item.Start) })
+
+		// Skip the duplicates, e.g. the same lesson listed in two timetables
+		if slices.ContainsFunc(active, func(a Item) bool {
+			return a.Kind == item.Kind && a.Code == item.Code && a.Start.Equal(item.Start) && a.End.Equal(item.End)
+		}) {
+			continue
+		}
+
+		for _, other := range active {
+			if other.Code != "" && other.Code == item.Code {
+				continue
+			}
+
+			end := other.End
+			if item.End.Before(end) {
+				end = item.End
+			}
+			if !end.After(item.Start) {
+				continue
+			}
+
+			conflicts = append(conflicts, Conflict{
+				A:       other,
+				B:       item,
+				Start:   item.Start,
+				End:     end,
+				Overlap: end.Sub(item.Start),
+			})
+		}
+
+		active = append(active, item)
+	}
+
+	slices.SortStableFunc(conflicts, func(a, b Conflict) int {
+		return cmp.Or(a.Start.Compare(b.Start), a.End.Compare(b.End))
+	})
+	return conflicts
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package conflicts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cartabinaria/unibo-go/exams"
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2024, time.October, 7, hour, minute, 0, 0, time.UTC)
+}
+
+func lesson(code string, start, end time.Time) timetable.Event {
+	return timetable.Event{CodModulo: code, Title: code, Start: timetable.CalendarTime{Time: start}, End: timetable.CalendarTime{Time: end}}
+}
+
+func TestFind(t *testing.T) {
+	first := timetable.Timetable{
+		lesson("A", at(9, 0), at(11, 0)),
+		lesson("B", at(14, 0), at(16, 0)),
+	}
+	second := timetable.Timetable{
+		lesson("C", at(10, 30), at(12, 0)), // Overlaps A by 30 minutes
+		lesson("D", at(16, 0), at(18, 0)),  // Touches B: no conflict
+		lesson("A", at(9, 0), at(11, 0)),   // Same teaching as A: no conflict
+	}
+	exam := []exams.Exam{{SubjectCode: "E", SubjectName: "Esame", Date: at(15, 0)}}
+
+	items := append(Lessons(first, second), Exams(exam, 2*time.Hour)...)
+	conflicts := Find(items)
+	require.Len(t, conflicts, 3, "unexpected conflicts: %v", conflicts)
+
+	assert.Equal(t, "A", conflicts[0].A.Code)
+	assert.Equal(t, "C", conflicts[0].B.Code)
+	assert.Equal(t, 30*time.Minute, conflicts[0].Overlap)
+
+	assert.Equal(t, "B", conflicts[1].A.Code)
+	assert.Equal(t, "E", conflicts[1].B.Code)
+	assert.Equal(t, KindExam, conflicts[1].B.Kind)
+	assert.Equal(t, time.Hour, conflicts[1].Overlap, "the overlap should end with the lesson")
+	assert.Equal(t, "Esame", conflicts[1].B.Exam.SubjectName)
+
+	assert.Equal(t, "E", conflicts[2].A.Code)
+	assert.Equal(t, "D", conflicts[2].B.Code)
+	assert.Equal(t, time.Hour, conflicts[2].Overlap, "the overlap should end with the exam")
+}
+
+func TestFindNone(t *testing.T) {
+	assert.Empty(t, Find(nil))
+	assert.Empty(t, Find(Lessons(timetable.Timetable{lesson("A", at(9, 0), at(11, 0))})))
+}
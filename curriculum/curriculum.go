@@ -8,16 +8,20 @@
 package curriculum
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"net/http"
 	"strings"
+
+	"github.com/cartabinaria/unibo-go"
 )
 
+var baseUrl = "https://corsi.unibo.it"
+
 const (
-	baseCurriculaIt = "https://corsi.unibo.it/%s/%s/orario-lezioni/@@available_curricula?anno=%d&curricula="
-	baseCurriculaEn = "https://corsi.unibo.it/%s/%s/timetable/@@available_curricula?anno=%d&curricula="
+	curriculaPathIt = "/%s/%s/orario-lezioni/@@available_curricula?anno=%d&curricula="
+	curriculaPathEn = "/%s/%s/timetable/@@available_curricula?anno=%d&curricula="
 )
 
 type (
@@ -29,21 +33,62 @@ type (
 	Curricula []Curriculum
 )
 
+// Client fetches curricula from the University website.
+//
+// The zero value uses unibo.DefaultClient.
+type Client struct {
+	client *unibo.Client
+}
+
+// NewClient creates a new curriculum Client configured with the given options.
+//
+// unibo.WithBaseURL replaces "https://corsi.unibo.it".
+func NewClient(opts ...unibo.Option) *Client {
+	return &Client{client: unibo.NewClient(opts...)}
+}
+
+// DefaultClient is the Client used by the package-level functions.
+var DefaultClient = &Client{}
+
+func (c *Client) http() *unibo.Client {
+	if c == nil || c.client == nil {
+		return unibo.DefaultClient
+	}
+	return c.client
+}
+
 func GetCurriculaUrl(courseType, courseId string, year int) string {
+	return DefaultClient.GetCurriculaUrl(courseType, courseId, year)
+}
+
+// GetCurriculaUrl returns the URL to fetch the curricula of the given course.
+func (c *Client) GetCurriculaUrl(courseType, courseId string, year int) string {
+	base := c.http().BaseURL(baseUrl)
 	if strings.Contains(courseType, "cycle") {
-		return fmt.Sprintf(baseCurriculaEn, courseType, courseId, year)
+		return fmt.Sprintf(base+curriculaPathEn, courseType, courseId, year)
 	} else {
-		return fmt.Sprintf(baseCurriculaIt, courseType, courseId, year)
+		return fmt.Sprintf(base+curriculaPathIt, courseType, courseId, year)
 	}
 }
 
 func FetchCurricula(courseType, courseId string, year int) (curricula Curricula, err error) {
-	url := GetCurriculaUrl(courseType, courseId, year)
+	return FetchCurriculaContext(context.Background(), courseType, courseId, year)
+}
+
+// FetchCurriculaContext is like FetchCurricula, but the request is bound to ctx.
+func FetchCurriculaContext(ctx context.Context, courseType, courseId string, year int) (Curricula, error) {
+	return DefaultClient.FetchCurricula(ctx, courseType, courseId, year)
+}
 
-	res, err := http.Get(url)
+// FetchCurricula returns the curricula of the given course for the given year.
+func (c *Client) FetchCurricula(ctx context.Context, courseType, courseId string, year int) (curricula Curricula, err error) {
+	url := c.GetCurriculaUrl(courseType, courseId, year)
+
+	res, err := c.http().Get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
+	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
@@ -59,10 +104,5 @@ func FetchCurricula(courseType, courseId string, year int) (curricula Curricula,
 		return nil, err
 	}
 
-	err = res.Body.Close()
-	if err != nil {
-		return nil, err
-	}
-
 	return
 }
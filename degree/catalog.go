@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/cartabinaria/unibo-go/internal/text"
+)
+
+// Catalog indexes a list of degrees, e.g. the ones returned by
+// opendata.GetDegrees, for lookups and search.
+//
+// The lookups return copies of the degrees, and a Catalog is safe for
+// concurrent use. The IDs resolved on a copy (e.g. by GetCurricula) are not
+// stored in the catalog: the IDs are stored, and found by ByID, when resolved
+// with Catalog.ResolveID or Catalog.FetchAllCurricula.
+type Catalog struct {
+	degrees []Degree
+
+	mu   sync.Mutex // Guards the IDs of the degrees and byID
+	byID map[ID]int // The degrees whose ID is known
+
+	byCode     map[string]int
+	byCampus   map[string][]int
+	byType     map[string][]int
+	byLanguage map[string][]int
+	words      [][]string // The normalized words of the searchable text of each degree
+}
+
+// NewCatalog creates a catalog of the given degrees. The slice is copied.
+func NewCatalog(degrees []Degree) *Catalog {
+	c := &Catalog{
+		degrees:    slices.Clone(degrees),
+		byID:       make(map[ID]int),
+		byCode:     make(map[string]int, len(degrees)),
+		byCampus:   make(map[string][]int),
+		byType:     make(map[string][]int),
+		byLanguage: make(map[string][]int),
+		words:      make([][]string, len(degrees)),
+	}
+
+	for i := range c.degrees {
+		d := &c.degrees[i]
+
+		c.byCode[d.Code] = i
+		if !d.ID.IsZero() {
+			c.byID[d.ID] = i
+		}
+		c.byCampus[text.Normalize(d.Campus)] = append(c.byCampus[text.Normalize(d.Campus)], i)
+		c.byType[text.Normalize(d.Type)] = append(c.byType[text.Normalize(d.Type)], i)
+		for _, lang := range d.languages() {
+			c.byLanguage[lang] = append(c.byLanguage[lang], i)
+		}
+
+		searchable := []string{d.Description, d.InternationalTitle, d.Campus, d.TeachingLocation}
+		if d.English != nil {
+			searchable = append(searchable, d.English.Description)
+		}
+		c.words[i] = strings.Fields(text.Normalize(strings.Join(searchable, " ")))
+	}
+
+	return c
+}
+
+// languages returns the normalized languages in which the degree is taught.
+func (d *Degree) languages() []string {
+	var languages []string
+	for _, s := range []string{d.Languages, d.InternationalLanguage} {
+		for _, lang := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' || r == '/' }) {
+			if lang = text.Normalize(lang); lang != "" && !slices.Contains(languages, lang) {
+				languages = append(languages, lang)
+			}
+		}
+	}
+	return languages
+}
+
+// Len returns the number of degrees in the catalog.
+func (c *Catalog) Len() int {
+	return len(c.degrees)
+}
+
+// Degrees returns all the degrees of the catalog.
+func (c *Catalog) Degrees() []Degree {
+	return c.copies(nil)
+}
+
+// copies returns the degrees at the given indexes, or all the degrees if indexes is nil.
+func (c *Catalog) copies(indexes []int) []Degree {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if indexes == nil {
+		return slices.Clone(c.degrees)
+	}
+
+	out := make([]Degree, len(indexes))
+	for i, index := range indexes {
+		out[i] = c.degrees[index]
+	}
+	return out
+}
+
+// ByCode returns the degree with the given code, e.g. "8009".
+func (c *Catalog) ByCode(code string) (Degree, bool) {
+	i, found := c.byCode[strings.TrimSpace(code)]
+	if !found {
+		return Degree{}, false
+	}
+	return c.degree(i), true
+}
+
+// ByID returns the degree with the given corsi.unibo.it ID.
+//
+// Only the degrees whose ID is known are considered: the IDs given to
+// NewCatalog, and the ones resolved by ResolveID and FetchAllCurricula.
+func (c *Catalog) ByID(id ID) (Degree, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i, found := c.byID[id]
+	if !found {
+		return Degree{}, false
+	}
+	return c.degrees[i], true
+}
+
+// degree returns a copy of the i-th degree.
+func (c *Catalog) degree(i int) Degree {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.degrees[i]
+}
+
+// setID sets and indexes the ID of the i-th degree.
+func (c *Catalog) setID(i int, id ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.degrees[i].ID = id
+	c.byID[id] = i
+}
+
+// ResolveID resolves the ID of the degree with the given code, if missing,
+// with DefaultResolver, and stores it in the catalog.
+func (c *Catalog) ResolveID(ctx context.Context, code string) (ID, error) {
+	return DefaultClient.ResolveCatalogID(ctx, c, code)
+}
+
+// ByCampus returns the degrees taught in the given campus, e.g. "Bologna".
+// The comparison ignores case and accents.
+func (c *Catalog) ByCampus(campus string) []Degree {
+	return c.copies(nonNil(c.byCampus[text.Normalize(campus)]))
+}
+
+// ByType returns the degrees of the given type, e.g. "Laurea Magistrale".
+// The comparison ignores case and accents.
+func (c *Catalog) ByType(typ string) []Degree {
+	return c.copies(nonNil(c.byType[text.Normalize(typ)]))
+}
+
+// ByLanguage returns the degrees taught in the given language, as written in
+// the open data, e.g. "Inglese". The comparison ignores case and accents.
+func (c *Catalog) ByLanguage(lang string) []Degree {
+	return c.copies(nonNil(c.byLanguage[text.Normalize(lang)]))
+}
+
+func nonNil(indexes []int) []int {
+	if indexes == nil {
+		return []int{}
+	}
+	return indexes
+}
+
+// Match is a result of Catalog.Search.
+type Match struct {
+	Degree Degree
+	Score  int // The higher, the better the degree matches the query
+}
+
+// Search returns the degrees matching query, best matches first. At most
+// limit results are returned, or all of them if limit is not positive.
+//
+// The query is split into words, which are compared ignoring case and
+// accents with the words of the description, the international title, the
+// English description and the campus of each degree. A query word matches if
+// it is equal to, a prefix of or contained in a word of the degree, or if it
+// differs by a single letter (for words of at least 4 letters). All the
+// words of the query must match: "ing inf bologna" finds "Ingegneria
+// informatica" in Bologna.
+func (c *Catalog) Search(query string, limit int) []Match {
+	terms := strings.Fields(text.Normalize(query))
+	if len(terms) == 0 {
+		return nil
+	}
+
+	var matches []Match
+	for i, words := range c.words {
+		score := 0
+		for _, term := range terms {
+			s := matchTerm(term, words)
+			if s == 0 {
+				score = 0
+				break
+			}
+			score += s
+		}
+		if score > 0 {
+			matches = append(matches, Match{Degree: c.degree(i), Score: score})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b Match) int {
+		if a.Score != b.Score {
+			return b.Score - a.Score
+		}
+		return cmp.Compare(a.Degree.Description, b.Degree.Description)
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// matchTerm returns how well term matches the best of words, or 0 if it does not match.
+func matchTerm(term string, words []string) int {
+	best := 0
+	for _, word := range words {
+		score := 0
+		switch {
+		case word == term:
+			score = 4
+		case strings.HasPrefix(word, term):
+			score = 3
+		case strings.Contains(word, term):
+			score = 2
+		case len(term) >= 4 && withinOneEdit(term, word):
+			score = 1
+		}
+		best = max(best, score)
+	}
+	return best
+}
+
+// withinOneEdit reports whether a and b differ by at most one insertion,
+// deletion or substitution.
+func withinOneEdit(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) > len(rb) {
+		ra, rb = rb, ra
+	}
+	if len(rb)-len(ra) > 1 {
+		return false
+	}
+
+	i := 0
+	for i < len(ra) && ra[i] == rb[i] {
+		i++
+	}
+	if len(ra) == len(rb) {
+		return string(ra[i+min(1, len(ra)-i):]) == string(rb[i+min(1, len(rb)-i):])
+	}
+	return string(ra[i:]) == string(rb[i+1:])
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDegrees = []Degree{
+	{Code: "9254", Description: "Ingegneria informatica", Campus: "Bologna", Type: "Laurea", Languages: "Italiano"},
+	{Code: "8614", Description: "Ingegneria informatica", Campus: "Cesena", Type: "Laurea", Languages: "Italiano"},
+	{Code: "8009", Description: "Informatica", Campus: "Bologna", Type: "Laurea", Languages: "Italiano"},
+	{Code: "9063", Description: "Informatica", Campus: "Bologna", Type: "Laurea Magistrale", Languages: "Inglese", International: true, InternationalTitle: "Computer Science", InternationalLanguage: "Inglese"},
+	{Code: "5818", Description: "Scienze dell'alimentazione", Campus: "Forlì", Type: "Laurea", Languages: "Italiano, Inglese"},
+}
+
+func TestCatalogLookups(t *testing.T) {
+	c := NewCatalog(testDegrees)
+	assert.Equal(t, len(testDegrees), c.Len())
+
+	d, found := c.ByCode("8009")
+	require.True(t, found, "ByCode should find the degree")
+	assert.Equal(t, "Informatica", d.Description)
+
+	_, found = c.ByCode("0000")
+	assert.False(t, found, "ByCode should not find a missing degree")
+
+	assert.Len(t, c.ByCampus("bologna"), 3, "ByCampus should ignore case")
+	assert.Len(t, c.ByCampus("Forli"), 1, "ByCampus should ignore accents")
+	assert.Len(t, c.ByType("laurea magistrale"), 1)
+	assert.Len(t, c.ByLanguage("inglese"), 2, "ByLanguage should split the languages")
+	assert.Empty(t, c.ByCampus("Rimini"))
+
+	id := ID{Type: "laurea", Id: "informatica"}
+	d.ID = id
+	_, found = c.ByID(id)
+	assert.False(t, found, "an ID set on a copy should not be stored in the catalog")
+
+	client := &Client{Resolver: staticResolver(id)}
+	_, err := client.ResolveCatalogID(context.Background(), c, "8009")
+	require.NoError(t, err, "ResolveCatalogID should not return an error")
+	d, found = c.ByID(id)
+	require.True(t, found, "ByID should find a degree with a resolved ID")
+	assert.Equal(t, "8009", d.Code)
+
+	_, err = client.ResolveCatalogID(context.Background(), c, "0000")
+	assert.Error(t, err, "ResolveCatalogID should fail for a degree not in the catalog")
+}
+
+// staticResolver resolves every degree to the same ID.
+type staticResolver ID
+
+func (r staticResolver) ResolveID(ctx context.Context, d *Degree) (ID, error) {
+	return ID(r), nil
+}
+
+func TestCatalogConcurrentResolve(t *testing.T) {
+	c := NewCatalog(testDegrees)
+	client := &Client{Resolver: staticResolver{Type: "laurea", Id: "informatica"}}
+
+	// Resolving the IDs of the degrees found by a lookup, as GetCurricula
+	// does, must not race with the lookups
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			d, _ := c.ByCode("8009")
+			_, err := client.ResolveID(context.Background(), &d)
+			assert.NoError(t, err)
+			_, err = client.ResolveCatalogID(context.Background(), c, "8009")
+			assert.NoError(t, err)
+		})
+		wg.Go(func() {
+			c.ByID(ID{Type: "laurea", Id: "informatica"})
+			c.Degrees()
+			c.Search("informatica", 1)
+		})
+	}
+	wg.Wait()
+
+	d, found := c.ByID(ID{Type: "laurea", Id: "informatica"})
+	require.True(t, found)
+	assert.Equal(t, "8009", d.Code)
+}
+
+func TestCatalogSearch(t *testing.T) {
+	c := NewCatalog(testDegrees)
+
+	matches := c.Search("ing inf bologna", 0)
+	require.Len(t, matches, 1, "all the words should match")
+	assert.Equal(t, "9254", matches[0].Degree.Code)
+
+	matches = c.Search("informatica", 0)
+	require.Len(t, matches, 4)
+	assert.Contains(t, []string{"8009", "9063"}, matches[0].Degree.Code, "exact matches should come first")
+
+	matches = c.Search("computer science", 0)
+	require.Len(t, matches, 1, "the international title should be searched")
+	assert.Equal(t, "9063", matches[0].Degree.Code)
+
+	matches = c.Search("alimentazióne forli", 0)
+	require.Len(t, matches, 1, "accents should be ignored")
+	assert.Equal(t, "5818", matches[0].Degree.Code)
+
+	matches = c.Search("informatca", 0)
+	assert.Len(t, matches, 4, "a typo should be tolerated")
+
+	assert.Len(t, c.Search("informatica", 2), 2, "results should be limited")
+	assert.Empty(t, c.Search("  ", 0))
+}
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/cartabinaria/unibo-go/curriculum"
+	"github.com/cartabinaria/unibo-go/exams"
+	"github.com/cartabinaria/unibo-go/teachings"
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+// Client fetches the data of degrees from the University website, with a
+// client for each package it relies on.
+//
+// The zero value uses DefaultResolver and the DefaultClient of each package.
+type Client struct {
+	Resolver         IDResolver         // Resolves the missing IDs. If nil, DefaultResolver is used.
+	CurriculumClient *curriculum.Client // If nil, curriculum.DefaultClient is used.
+	TimetableClient  *timetable.Client  // If nil, timetable.DefaultClient is used.
+	ExamsClient      *exams.Client      // If nil, exams.DefaultClient is used.
+	TeachingsClient  *teachings.Client  // If nil, teachings.DefaultClient is used.
+
+	client *unibo.Client // The client used to scrape the degree websites
+}
+
+// NewClient creates a new degree Client whose clients, and the ScrapeResolver
+// of its missing IDs, are all configured with the given options.
+//
+// unibo.WithBaseURL replaces "https://corsi.unibo.it" for the data of the
+// course websites, not the degree websites scraped for the IDs.
+func NewClient(opts ...unibo.Option) *Client {
+	client := unibo.NewClient(opts...)
+	return &Client{
+		Resolver:         ScrapeResolver{Client: client},
+		CurriculumClient: curriculum.NewClient(opts...),
+		TimetableClient:  timetable.NewClient(opts...),
+		ExamsClient:      exams.NewClient(opts...),
+		TeachingsClient:  teachings.NewClient(opts...),
+		client:           client,
+	}
+}
+
+// DefaultClient is the Client used by the methods of Degree.
+var DefaultClient = &Client{}
+
+func (c *Client) http() *unibo.Client {
+	if c == nil || c.client == nil {
+		return unibo.DefaultClient
+	}
+	return c.client
+}
+
+func (c *Client) resolver() IDResolver {
+	if c != nil && c.Resolver != nil {
+		return c.Resolver
+	} else if DefaultResolver != nil {
+		return DefaultResolver
+	}
+	return ScrapeResolver{}
+}
+
+func (c *Client) curriculum() *curriculum.Client {
+	if c == nil || c.CurriculumClient == nil {
+		return curriculum.DefaultClient
+	}
+	return c.CurriculumClient
+}
+
+func (c *Client) timetable() *timetable.Client {
+	if c == nil || c.TimetableClient == nil {
+		return timetable.DefaultClient
+	}
+	return c.TimetableClient
+}
+
+func (c *Client) exams() *exams.Client {
+	if c == nil || c.ExamsClient == nil {
+		return exams.DefaultClient
+	}
+	return c.ExamsClient
+}
+
+func (c *Client) teachings() *teachings.Client {
+	if c == nil || c.TeachingsClient == nil {
+		return teachings.DefaultClient
+	}
+	return c.TeachingsClient
+}
+
+// ResolveID sets the ID of the degree, if missing, with the resolver of the
+// client, and returns it.
+func (c *Client) ResolveID(ctx context.Context, d *Degree) (ID, error) {
+	if !d.ID.IsZero() {
+		return d.ID, nil
+	}
+
+	id, err := c.resolver().ResolveID(ctx, d)
+	if err != nil {
+		return ID{}, err
+	}
+
+	d.ID = id
+	return id, nil
+}
+
+// ResolveCatalogID is like Catalog.ResolveID, but the ID is resolved with the
+// resolver of the client.
+func (c *Client) ResolveCatalogID(ctx context.Context, catalog *Catalog, code string) (ID, error) {
+	i, found := catalog.byCode[strings.TrimSpace(code)]
+	if !found {
+		return ID{}, fmt.Errorf("unable to resolve the ID of degree %s: not in the catalog", code)
+	}
+
+	d := catalog.degree(i)
+	id, err := c.ResolveID(ctx, &d)
+	if err != nil {
+		return ID{}, err
+	}
+	catalog.setID(i, id)
+	return id, nil
+}
+
+// ScrapeID returns the ID of the course, scraped from the degree website
+// (Degree.Url), without setting it.
+func (c *Client) ScrapeID(ctx context.Context, d *Degree) (ID, error) {
+	return scrapeId(ctx, c.http(), d.Url)
+}
+
+// Curricula returns the curricula of the degree for the given year.
+// The year must be between 1 and the duration of the degree.
+func (c *Client) Curricula(ctx context.Context, d *Degree, year int) (curriculum.Curricula, error) {
+	_, err := c.ResolveID(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.curriculum().FetchCurricula(ctx, d.ID.Type, d.ID.Id, year)
+}
+
+// Timetable returns the timetable of the degree for the given year, curriculum and period.
+//
+// See timetable.FetchTimetable for more information.
+func (c *Client) Timetable(
+	ctx context.Context,
+	d *Degree,
+	year int,
+	curriculum curriculum.Curriculum,
+	period *timetable.Interval,
+) (timetable.Timetable, error) {
+	_, err := c.ResolveID(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.timetable().FetchTimetable(ctx, d.ID.Type, d.ID.Id, curriculum.Value, year, period)
+}
+
+// Teachings returns the teachings of the degree for the given year and
+// curriculum. A zero curriculum selects the default one.
+func (c *Client) Teachings(ctx context.Context, d *Degree, year int, curriculum curriculum.Curriculum) ([]teachings.Teaching, error) {
+	_, err := c.ResolveID(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.teachings().FetchTeachings(ctx, d.ID.Type, d.ID.Id, year, curriculum.Value)
+}
+
+// Exams returns the exams of the degree.
+func (c *Client) Exams(ctx context.Context, d *Degree) ([]exams.Exam, error) {
+	_, err := c.ResolveID(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.exams().GetExams(ctx, d.ID.Type, d.ID.Id)
+}
+
+// ExamsForSubject returns the exams of the degree for the given subject.
+func (c *Client) ExamsForSubject(ctx context.Context, d *Degree, subjectName string) ([]exams.Exam, error) {
+	_, err := c.ResolveID(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.exams().GetExamsForSubject(ctx, d.ID.Type, d.ID.Id, subjectName)
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/cartabinaria/unibo-go/curriculum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/magistrale/Informatica", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<a title="Sito del corso" href="https://corsi.unibo.it/laurea/Informatica">Sito</a>`))
+	})
+	handler.HandleFunc("/laurea/Informatica/orario-lezioni/@@available_curricula", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"value": "000-000", "label": "Generico"}]`))
+	})
+	handler.HandleFunc("/laurea/Informatica/orario-lezioni/@@orario_reale_json", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "000-000", r.URL.Query().Get("curricula"), "unexpected curriculum")
+		_, _ = w.Write([]byte(`[{"cod_modulo": "00819", "start": "2024-10-07T09:00:00", "end": "2024-10-07T11:00:00"}]`))
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// The default clients must not be used
+	client := NewClient(unibo.WithBaseURL(server.URL))
+	d := Degree{Code: "8009", Url: server.URL + "/magistrale/Informatica", DurationInYears: 1}
+
+	id, err := client.ScrapeID(context.Background(), &d)
+	require.NoError(t, err)
+	assert.Equal(t, ID{Type: "laurea", Id: "Informatica"}, id)
+	assert.True(t, d.ID.IsZero(), "ScrapeID should not set the ID")
+
+	curricula, err := client.Curricula(context.Background(), &d, 1)
+	require.NoError(t, err, "the ID should be resolved with the client")
+	require.Len(t, curricula, 1)
+	assert.Equal(t, ID{Type: "laurea", Id: "Informatica"}, d.ID)
+
+	tt, err := client.Timetable(context.Background(), &d, 1, curricula[0], nil)
+	require.NoError(t, err)
+	require.Len(t, tt, 1)
+	assert.Equal(t, "00819", tt[0].CodModulo)
+
+	all, err := client.FetchAllCurricula(context.Background(), &d, 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[int]curriculum.Curricula{1: curricula}, all)
+}
@@ -0,0 +1,188 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/cartabinaria/unibo-go/curriculum"
+)
+
+// DefaultConcurrency is the maximum number of concurrent requests made by
+// GetAllCurricula.
+const DefaultConcurrency = 4
+
+// YearError is the error of fetching the curricula of a single year.
+type YearError struct {
+	Year int
+	Err  error
+}
+
+func (e *YearError) Error() string {
+	return fmt.Sprintf("year %d: %v", e.Year, e.Err)
+}
+
+func (e *YearError) Unwrap() error {
+	return e.Err
+}
+
+// DegreeError is the error of fetching the curricula of a single degree.
+type DegreeError struct {
+	Code string // The code of the degree
+	Err  error
+}
+
+func (e *DegreeError) Error() string {
+	return fmt.Sprintf("degree %s: %v", e.Code, e.Err)
+}
+
+func (e *DegreeError) Unwrap() error {
+	return e.Err
+}
+
+// limiter bounds the number of concurrent requests.
+type limiter chan struct{}
+
+func newLimiter(concurrency int) limiter {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	return make(limiter, concurrency)
+}
+
+// acquire waits for a free slot, or returns the error of ctx if it is done first.
+func (l limiter) acquire(ctx context.Context) error {
+	select {
+	case l <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (l limiter) release() {
+	<-l
+}
+
+// FetchAllCurricula returns the curricula of each year of the degree, keyed
+// by year, making at most concurrency requests at a time (DefaultConcurrency
+// if not positive).
+//
+// A failed year does not stop the others: the returned map holds the years
+// fetched successfully, and the error joins a *YearError for each failed
+// year. If ctx is canceled, the pending years fail with the error of ctx.
+//
+//	all, err := d.FetchAllCurricula(ctx, 2)
+//	var yearErr *degree.YearError
+//	if errors.As(err, &yearErr) {
+//		log.Printf("year %d is missing: %v", yearErr.Year, yearErr.Err)
+//	}
+func (d *Degree) FetchAllCurricula(ctx context.Context, concurrency int) (map[int]curriculum.Curricula, error) {
+	return DefaultClient.FetchAllCurricula(ctx, d, concurrency)
+}
+
+// FetchAllCurricula is like Degree.FetchAllCurricula, but the requests are
+// made with the client.
+func (c *Client) FetchAllCurricula(ctx context.Context, d *Degree, concurrency int) (map[int]curriculum.Curricula, error) {
+	return c.fetchAllCurricula(ctx, d, newLimiter(concurrency))
+}
+
+func (c *Client) fetchAllCurricula(ctx context.Context, d *Degree, limit limiter) (map[int]curriculum.Curricula, error) {
+	err := limit.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.ResolveID(ctx, d)
+	limit.release()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		all  = make(map[int]curriculum.Curricula, d.DurationInYears)
+		errs []error
+	)
+
+	for year := 1; year <= d.DurationInYears; year++ {
+		wg.Go(func() {
+			curricula, err := c.fetchCurricula(ctx, d, limit, year)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, &YearError{Year: year, Err: err})
+				return
+			}
+			all[year] = curricula
+		})
+	}
+	wg.Wait()
+
+	return all, errors.Join(errs...)
+}
+
+func (c *Client) fetchCurricula(ctx context.Context, d *Degree, limit limiter, year int) (curriculum.Curricula, error) {
+	err := limit.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer limit.release()
+
+	return c.curriculum().FetchCurricula(ctx, d.ID.Type, d.ID.Id, year)
+}
+
+// FetchAllCurricula returns the curricula of every degree of the catalog,
+// keyed by degree code and then by year, making at most concurrency requests
+// at a time overall (DefaultConcurrency if not positive). The IDs of the
+// degrees are resolved if missing.
+//
+// A failed degree or year does not stop the others: the returned map holds
+// what was fetched successfully, and the error joins a *DegreeError for each
+// degree with errors, wrapping its *YearError values.
+func (c *Catalog) FetchAllCurricula(ctx context.Context, concurrency int) (map[string]map[int]curriculum.Curricula, error) {
+	return DefaultClient.FetchCatalogCurricula(ctx, c, concurrency)
+}
+
+// FetchCatalogCurricula is like Catalog.FetchAllCurricula, but the requests
+// are made with the client.
+func (c *Client) FetchCatalogCurricula(ctx context.Context, catalog *Catalog, concurrency int) (map[string]map[int]curriculum.Curricula, error) {
+	limit := newLimiter(concurrency)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		all  = make(map[string]map[int]curriculum.Curricula, len(catalog.degrees))
+		errs []error
+	)
+
+	for i := range catalog.degrees {
+		wg.Go(func() {
+			// The ID is resolved on a copy, and stored in the catalog under
+			// its lock, since ByID can read it concurrently
+			d := catalog.degree(i)
+			curricula, err := c.fetchAllCurricula(ctx, &d, limit)
+			if !d.ID.IsZero() {
+				catalog.setID(i, d.ID)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(curricula) > 0 {
+				all[d.Code] = curricula
+			}
+			if err != nil {
+				errs = append(errs, &DegreeError{Code: d.Code, Err: err})
+			}
+		})
+	}
+	wg.Wait()
+
+	return all, errors.Join(errs...)
+}
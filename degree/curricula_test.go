@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/cartabinaria/unibo-go/curriculum"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveCurricula replaces curriculum.DefaultClient with one querying a test
+// server, which fails for year 2 of "Rotto" and reports the maximum number of
+// concurrent requests.
+func serveCurricula(t *testing.T) *atomic.Int32 {
+	t.Helper()
+
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		year := r.URL.Query().Get("anno")
+		if r.URL.Path == "/laurea/Rotto/orario-lezioni/@@available_curricula" && year == "2" {
+			_, _ = w.Write([]byte(`{"error": "not found"}`))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `[{"value": "000-%s", "label": "Anno %s"}]`, year, year)
+	}))
+	t.Cleanup(server.Close)
+
+	previous := curriculum.DefaultClient
+	curriculum.DefaultClient = curriculum.NewClient(unibo.WithBaseURL(server.URL))
+	t.Cleanup(func() { curriculum.DefaultClient = previous })
+
+	return &peak
+}
+
+func TestFetchAllCurricula(t *testing.T) {
+	peak := serveCurricula(t)
+
+	d := Degree{ID: ID{Type: "laurea", Id: "Informatica"}, DurationInYears: 3}
+	all, err := d.FetchAllCurricula(context.Background(), 2)
+	require.NoError(t, err, "FetchAllCurricula should not return an error")
+	require.Len(t, all, 3, "every year should be fetched")
+	for year, curricula := range all {
+		assert.Equal(t, fmt.Sprintf("000-%d", year), curricula[0].Value, "curricula should be keyed by their year")
+	}
+	assert.LessOrEqual(t, peak.Load(), int32(2), "the concurrency limit should be respected")
+}
+
+func TestFetchAllCurriculaPartial(t *testing.T) {
+	serveCurricula(t)
+
+	d := Degree{ID: ID{Type: "laurea", Id: "Rotto"}, DurationInYears: 3}
+	all, err := d.FetchAllCurricula(context.Background(), 0)
+	require.Error(t, err, "FetchAllCurricula should report the failed year")
+	assert.Len(t, all, 2, "the other years should be returned")
+
+	var yearErr *YearError
+	require.ErrorAs(t, err, &yearErr)
+	assert.Equal(t, 2, yearErr.Year)
+}
+
+func TestFetchAllCurriculaCanceled(t *testing.T) {
+	serveCurricula(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	d := Degree{ID: ID{Type: "laurea", Id: "Informatica"}, DurationInYears: 3}
+	_, err := d.FetchAllCurricula(ctx, 1)
+	assert.ErrorIs(t, err, context.Canceled, "a canceled context should stop the requests")
+}
+
+func TestCatalogFetchAllCurricula(t *testing.T) {
+	peak := serveCurricula(t)
+
+	// The ID of 8009 is resolved by FetchAllCurricula
+	previous := DefaultResolver
+	DefaultResolver = &countingResolver{id: ID{Type: "laurea", Id: "Informatica"}}
+	t.Cleanup(func() { DefaultResolver = previous })
+
+	c := NewCatalog([]Degree{
+		{Code: "8009", DurationInYears: 3},
+		{Code: "9063", ID: ID{Type: "magistrale", Id: "Informatica"}, DurationInYears: 2},
+		{Code: "0000", ID: ID{Type: "laurea", Id: "Rotto"}, DurationInYears: 2},
+	})
+
+	// The IDs written by FetchAllCurricula must not race with the lookups
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	wg.Go(func() {
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				c.ByID(ID{Type: "laurea", Id: "Informatica"})
+			}
+		}
+	})
+
+	all, err := c.FetchAllCurricula(context.Background(), 3)
+	close(done)
+	wg.Wait()
+	require.Error(t, err, "FetchAllCurricula should report the failed degree")
+	assert.Len(t, all["8009"], 3)
+	assert.Len(t, all["9063"], 2)
+	assert.Len(t, all["0000"], 1, "the successful years of a failed degree should be returned")
+	assert.LessOrEqual(t, peak.Load(), int32(3), "the concurrency limit should be shared")
+
+	d, found := c.ByID(ID{Type: "laurea", Id: "Informatica"})
+	require.True(t, found, "the resolved ID should be indexed")
+	assert.Equal(t, "8009", d.Code)
+
+	var degreeErr *DegreeError
+	require.ErrorAs(t, err, &degreeErr)
+	assert.Equal(t, "0000", degreeErr.Code)
+	assert.True(t, errors.As(err, new(*YearError)), "the year errors should be wrapped")
+}
@@ -5,11 +5,20 @@
 
 // Package degree provides a type to represent a degree course and related
 // functions to fetch data from the unibo website.
+//
+// The methods of Degree use DefaultClient. Use a Client to configure the
+// requests, e.g. with a base URL or a timeout:
+//
+//	c := degree.NewClient(unibo.WithTimeout(10 * time.Second))
+//	t, err := c.Timetable(ctx, &d, 1, curriculum.Curriculum{}, nil)
 package degree
 
 import (
+	"context"
+
 	"github.com/cartabinaria/unibo-go/curriculum"
 	"github.com/cartabinaria/unibo-go/exams"
+	"github.com/cartabinaria/unibo-go/teachings"
 	"github.com/cartabinaria/unibo-go/timetable"
 )
 
@@ -33,63 +42,63 @@ type Degree struct {
 	AccessRequirements    string // The access requirements
 	TeachingLocation      string // The main location where the course is taught
 
-	// The id of the course. It is used internally to fetch data from the unibo
-	// website. If it is empty, it will be fetched.
-	id ID
+	// The English version of the textual fields above, if known. It is set by
+	// opendata.GetMergedDegrees, and nil otherwise.
+	English *Translation
+
+	// The corsi.unibo.it ID of the course, used to fetch data from the course
+	// website. If it is zero, it is resolved with DefaultResolver when needed.
+	ID ID `json:",omitzero"`
+}
+
+// Translation holds the textual fields of a Degree in another language.
+type Translation struct {
+	Description         string // The description of the course
+	Url                 string // The url of the course website in the language
+	Campus              string // The campus where the course is taught
+	Fields              string // The fields that the course covers
+	Type                string // The type of the course, e.g. "First cycle degree"
+	OpenForRegistration string // Whether the course is open for registration
+	Languages           string // The languages in which the course is taught
+	AccessRequirements  string // The access requirements
+	TeachingLocation    string // The main location where the course is taught
+}
+
+// EnglishDescription returns the English description of the degree, falling
+// back to the international title and then to the Italian description.
+func (d *Degree) EnglishDescription() string {
+	if d.English != nil && d.English.Description != "" {
+		return d.English.Description
+	} else if d.InternationalTitle != "" {
+		return d.InternationalTitle
+	}
+	return d.Description
 }
 
 // GetCurricula returns the curricula of the degree for the given year.
 // The year must be between 1 and the duration of the degree.
 func (d *Degree) GetCurricula(year int) (curriculum.Curricula, error) {
-	err := d.fillId()
-	if err != nil {
-		return nil, err
-	}
-
-	curricula, err := curriculum.FetchCurricula(d.id.Type, d.id.Id, year)
-	if err != nil {
-		return nil, err
-	}
+	return d.GetCurriculaContext(context.Background(), year)
+}
 
-	return curricula, nil
+// GetCurriculaContext is like GetCurricula, but the requests are bound to ctx.
+func (d *Degree) GetCurriculaContext(ctx context.Context, year int) (curriculum.Curricula, error) {
+	return DefaultClient.Curricula(ctx, d, year)
 }
 
 // GetAllCurricula returns a map of all curricula of the degree.
 // The keys are the years of the curricula.
 //
-// Internally, it calls GetCurricula for each year in a separate goroutine,
-// so it is faster than calling GetCurricula for each year.
+// Internally, it fetches up to DefaultConcurrency years at a time, so it is
+// faster than calling GetCurricula for each year. See FetchAllCurricula for
+// how errors are reported.
 func (d *Degree) GetAllCurricula() (map[int]curriculum.Curricula, error) {
-	err := d.fillId()
-	if err != nil {
-		return nil, err
-	}
-
-	currCh := make(chan curriculum.Curricula)
-	errCh := make(chan error)
-
-	for year := 1; year <= d.DurationInYears; year++ {
-		go func(year int) {
-			curricula, err := curriculum.FetchCurricula(d.id.Type, d.id.Id, year)
-			if err != nil {
-				errCh <- err
-				return
-			}
-			currCh <- curricula
-		}(year)
-	}
-
-	curriculaMap := make(map[int]curriculum.Curricula, d.DurationInYears)
-	for year := 1; year <= d.DurationInYears; year++ {
-		select {
-		case curricula := <-currCh:
-			curriculaMap[year] = curricula
-		case err := <-errCh:
-			return nil, err
-		}
-	}
+	return d.GetAllCurriculaContext(context.Background())
+}
 
-	return curriculaMap, nil
+// GetAllCurriculaContext is like GetAllCurricula, but the requests are bound to ctx.
+func (d *Degree) GetAllCurriculaContext(ctx context.Context) (map[int]curriculum.Curricula, error) {
+	return DefaultClient.FetchAllCurricula(ctx, d, DefaultConcurrency)
 }
 
 // GetTimetable returns the timetable of the degree for the given year, curriculum and period.
@@ -101,37 +110,46 @@ func (d *Degree) GetTimetable(
 	curriculum curriculum.Curriculum,
 	period *timetable.Interval,
 ) (timetable.Timetable, error) {
-	err := d.fillId()
-	if err != nil {
-		return nil, err
-	}
-
-	t, err := timetable.FetchTimetable(d.id.Type, d.id.Id, curriculum.Value, year, period)
-	if err != nil {
-		return nil, err
-	}
-
-	return t, nil
+	return d.GetTimetableContext(context.Background(), year, curriculum, period)
 }
 
-func (d *Degree) fillId() error {
-	if d.id != (ID{}) {
-		return nil
-	}
+// GetTimetableContext is like GetTimetable, but the requests are bound to ctx.
+func (d *Degree) GetTimetableContext(
+	ctx context.Context,
+	year int,
+	curriculum curriculum.Curriculum,
+	period *timetable.Interval,
+) (timetable.Timetable, error) {
+	return DefaultClient.Timetable(ctx, d, year, curriculum, period)
+}
 
-	id, err := d.ScrapeId()
-	if err != nil {
-		return err
-	}
+// Teachings returns the teachings of the degree for the given year and curriculum.
+//
+// Use GetCurricula or GetAllCurricula to get a curriculum. A zero curriculum
+// selects the default one. See teachings.FetchTeachings for more information.
+func (d *Degree) Teachings(year int, curriculum curriculum.Curriculum) ([]teachings.Teaching, error) {
+	return d.TeachingsContext(context.Background(), year, curriculum)
+}
 
-	d.id = id
-	return nil
+// TeachingsContext is like Teachings, but the requests are bound to ctx.
+func (d *Degree) TeachingsContext(ctx context.Context, year int, curriculum curriculum.Curriculum) ([]teachings.Teaching, error) {
+	return DefaultClient.Teachings(ctx, d, year, curriculum)
 }
 
 func (d *Degree) Exams() ([]exams.Exam, error) {
-	return exams.GetExams(d.id.Type, d.id.Id)
+	return d.ExamsContext(context.Background())
+}
+
+// ExamsContext is like Exams, but the requests are bound to ctx.
+func (d *Degree) ExamsContext(ctx context.Context) ([]exams.Exam, error) {
+	return DefaultClient.Exams(ctx, d)
 }
 
 func (d *Degree) ExamsForSubject(subjectName string) ([]exams.Exam, error) {
-	return exams.GetExamsForSubject(d.id.Type, d.id.Id, subjectName)
+	return d.ExamsForSubjectContext(context.Background(), subjectName)
+}
+
+// ExamsForSubjectContext is like ExamsForSubject, but the requests are bound to ctx.
+func (d *Degree) ExamsForSubjectContext(ctx context.Context, subjectName string) ([]exams.Exam, error) {
+	return DefaultClient.ExamsForSubject(ctx, d, subjectName)
 }
@@ -5,26 +5,129 @@
 package degree
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
+
+	"github.com/cartabinaria/unibo-go"
 )
 
 // ID represents the ID of a course. It is made of a type and an id.
+//
+// It is encoded as text (e.g. in JSON) as "type/id", e.g. "laurea/IngegneriaInformatica".
 type ID struct {
 	Type string // Type is the type of the course, e.g. "laurea".
 	Id   string // Id is the id of the course, e.g. "IngegneriaInformatica".
 }
 
+// ParseID parses an ID in the form "type/id", e.g. "laurea/IngegneriaInformatica".
+func ParseID(s string) (ID, error) {
+	typ, id, found := strings.Cut(strings.Trim(strings.TrimSpace(s), "/"), "/")
+	if !found || typ == "" || id == "" || strings.Contains(id, "/") {
+		return ID{}, fmt.Errorf("invalid degree id %q: expected type/id", s)
+	}
+	return ID{Type: typ, Id: id}, nil
+}
+
+// IsZero reports whether the ID is unknown.
+func (id ID) IsZero() bool {
+	return id == ID{}
+}
+
+// String returns the ID in the form "type/id".
+func (id ID) String() string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.Type + "/" + id.Id
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (id ID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. An empty text is the zero ID.
+func (id *ID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*id = ID{}
+		return nil
+	}
+
+	parsed, err := ParseID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// FromID returns a Degree with the given ID, for callers that already know
+// it, e.g. from the url https://corsi.unibo.it/laurea/IngegneriaInformatica.
+//
+// Only the ID is set: the methods that need the duration of the degree, such
+// as GetAllCurricula, require DurationInYears to be set too.
+func FromID(id ID) Degree {
+	return Degree{ID: id}
+}
+
+// IDResolver resolves the ID of a degree, e.g. by scraping its website.
+type IDResolver interface {
+	ResolveID(ctx context.Context, d *Degree) (ID, error)
+}
+
+// DefaultResolver is the IDResolver used by the methods of Degree to resolve
+// a missing ID. If nil, ScrapeResolver is used.
+//
+// It can be replaced with a FileCache to avoid scraping the same degrees again:
+//
+//	degree.DefaultResolver = degree.NewFileCache("ids.json", degree.ScrapeResolver{})
+var DefaultResolver IDResolver = ScrapeResolver{}
+
+// ResolveID sets the ID of the degree, if missing, with DefaultResolver, and returns it.
+func (d *Degree) ResolveID(ctx context.Context) (ID, error) {
+	return DefaultClient.ResolveID(ctx, d)
+}
+
+// ScrapeResolver resolves the ID of a degree by downloading its website
+// (Degree.Url) and looking for the link to the course website.
+type ScrapeResolver struct {
+	Client *unibo.Client // The client used for the requests. If nil, unibo.DefaultClient is used.
+}
+
+// ResolveID implements IDResolver.
+func (r ScrapeResolver) ResolveID(ctx context.Context, d *Degree) (ID, error) {
+	client := r.Client
+	if client == nil {
+		client = unibo.DefaultClient
+	}
+	return scrapeId(ctx, client, d.Url)
+}
+
 var reg = regexp.MustCompile(`<a title="Sito del corso" href="https://corsi\.unibo\.it/(.+?)"`)
 
 // ScrapeId returns the ID of the course from the given course website url.
 func (d *Degree) ScrapeId() (ID, error) {
+	return d.ScrapeIdContext(context.Background())
+}
 
-	resp, err := http.Get(d.Url)
+// ScrapeIdContext is like ScrapeId, but the request is bound to ctx.
+//
+// The request is made with DefaultClient. Use Client.ScrapeID to make it with
+// another client.
+func (d *Degree) ScrapeIdContext(ctx context.Context) (ID, error) {
+	return DefaultClient.ScrapeID(ctx, d)
+}
+
+func scrapeId(ctx context.Context, client *unibo.Client, url string) (ID, error) {
+	resp, err := client.Get(ctx, url)
 	if err != nil {
 		return ID{}, fmt.Errorf("could not get course website: %w", err)
 	}
@@ -54,5 +157,108 @@ func (d *Degree) ScrapeId() (ID, error) {
 
 	// laurea/IngegneriaInformatica -> IngegneriaInformatica
 	split := strings.Split(id, "/")
+	if len(split) < 2 {
+		return ID{}, fmt.Errorf("unexpected course website %q (the website has changed?)", id)
+	}
 	return ID{Type: split[0], Id: split[1]}, nil
 }
+
+// FileCache is an IDResolver that stores the resolved IDs in a JSON file,
+// keyed by Degree.Code, and asks the next resolver for the missing ones.
+//
+// It is safe for concurrent use. The file is read at the first resolution,
+// and rewritten each time a new ID is resolved.
+type FileCache struct {
+	path string
+	next IDResolver
+
+	mu     sync.Mutex
+	ids    map[string]ID
+	loaded bool
+}
+
+// NewFileCache returns a FileCache stored at path, which is created if
+// missing, resolving the missing IDs with next.
+func NewFileCache(path string, next IDResolver) *FileCache {
+	return &FileCache{path: path, next: next}
+}
+
+// ResolveID implements IDResolver.
+func (c *FileCache) ResolveID(ctx context.Context, d *Degree) (ID, error) {
+	c.mu.Lock()
+	err := c.load()
+	id, found := c.ids[d.Code]
+	c.mu.Unlock()
+	if err != nil {
+		return ID{}, err
+	} else if found {
+		return id, nil
+	}
+
+	id, err = c.next.ResolveID(ctx, d)
+	if err != nil {
+		return ID{}, err
+	}
+
+	// Degrees without a code cannot be cached
+	if d.Code == "" {
+		return id, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ids[d.Code] = id
+	return id, c.save()
+}
+
+// load reads the file, if not already read. It must be called with mu held.
+func (c *FileCache) load() error {
+	if c.loaded {
+		return nil
+	}
+
+	c.ids = make(map[string]ID)
+	buf, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		c.loaded = true
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to read id cache: %w", err)
+	}
+
+	err = json.Unmarshal(buf, &c.ids)
+	if err != nil {
+		return fmt.Errorf("unable to parse id cache: %w", err)
+	}
+
+	c.loaded = true
+	return nil
+}
+
+// save writes the file, replacing it atomically. It must be called with mu held.
+func (c *FileCache) save() error {
+	buf, err := json.MarshalIndent(c.ids, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to encode id cache: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), "."+filepath.Base(c.path)+".*")
+	if err != nil {
+		return fmt.Errorf("unable to write id cache: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(buf)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write id cache: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), c.path)
+	if err != nil {
+		return fmt.Errorf("unable to write id cache: %w", err)
+	}
+	return nil
+}
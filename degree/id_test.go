@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseID(t *testing.T) {
+	id, err := ParseID("laurea/IngegneriaInformatica")
+	require.NoError(t, err, "ParseID should not return an error")
+	assert.Equal(t, ID{Type: "laurea", Id: "IngegneriaInformatica"}, id)
+	assert.Equal(t, "laurea/IngegneriaInformatica", id.String())
+
+	for _, s := range []string{"", "laurea", "laurea/", "/Informatica", "a/b/c"} {
+		_, err := ParseID(s)
+		assert.Error(t, err, "ParseID(%q) should fail", s)
+	}
+}
+
+func TestDegreeJSON(t *testing.T) {
+	d := FromID(ID{Type: "magistrale", Id: "Informatica"})
+	d.Code = "9063"
+
+	buf, err := json.Marshal(d)
+	require.NoError(t, err, "Marshal should not return an error")
+	assert.Contains(t, string(buf), `"ID":"magistrale/Informatica"`, "the ID should be encoded as text")
+
+	var decoded Degree
+	require.NoError(t, json.Unmarshal(buf, &decoded), "Unmarshal should not return an error")
+	assert.Equal(t, d, decoded, "the degree should survive a round trip")
+
+	buf, err = json.Marshal(Degree{Code: "8009"})
+	require.NoError(t, err, "Marshal should not return an error")
+	assert.NotContains(t, string(buf), `"ID"`, "a zero ID should be omitted")
+}
+
+type countingResolver struct {
+	id    ID
+	calls int
+}
+
+func (r *countingResolver) ResolveID(ctx context.Context, d *Degree) (ID, error) {
+	r.calls++
+	return r.id, nil
+}
+
+func TestFileCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ids.json")
+	next := &countingResolver{id: ID{Type: "laurea", Id: "Informatica"}}
+
+	cache := NewFileCache(path, next)
+	for range 2 {
+		id, err := cache.ResolveID(context.Background(), &Degree{Code: "8009"})
+		require.NoError(t, err, "ResolveID should not return an error")
+		assert.Equal(t, next.id, id)
+	}
+	assert.Equal(t, 1, next.calls, "the second resolution should be cached")
+
+	// A new cache reads the file
+	cache = NewFileCache(path, next)
+	_, err := cache.ResolveID(context.Background(), &Degree{Code: "8009"})
+	require.NoError(t, err, "ResolveID should not return an error")
+	assert.Equal(t, 1, next.calls, "the ID should be read from the file")
+}
+
+func TestScrapeResolver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><a title="Sito del corso" href="https://corsi.unibo.it/laurea/IngegneriaInformatica">Sito</a></html>`))
+	}))
+	defer server.Close()
+
+	d := Degree{Url: server.URL}
+	resolver := ScrapeResolver{Client: unibo.NewClient()}
+
+	previous := DefaultResolver
+	DefaultResolver = resolver
+	defer func() { DefaultResolver = previous }()
+
+	id, err := d.ResolveID(context.Background())
+	require.NoError(t, err, "ResolveID should not return an error")
+	assert.Equal(t, ID{Type: "laurea", Id: "IngegneriaInformatica"}, id)
+	assert.Equal(t, id, d.ID, "the ID should be set on the degree")
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/cartabinaria/unibo-go/timetable"
+)
+
+// PlanEntry is a group of teachings of a study plan, attended with a single
+// degree, year and curriculum.
+type PlanEntry struct {
+	Degree     ID     `json:"degree"`
+	Year       int    `json:"year"`
+	Curriculum string `json:"curriculum,omitempty"`
+
+	// Modules are the codes of the teachings or modules attended, matched as
+	// in timetable.Event.HasModule. If empty, all the lessons are kept.
+	Modules []string `json:"modules,omitempty"`
+}
+
+func (e PlanEntry) String() string {
+	s := fmt.Sprintf("%s/%d", e.Degree, e.Year)
+	if e.Curriculum != "" {
+		s += "/" + e.Curriculum
+	}
+	return s
+}
+
+// includes reports whether the event belongs to one of the modules of the entry.
+func (e PlanEntry) includes(event timetable.Event) bool {
+	return len(e.Modules) == 0 || slices.ContainsFunc(e.Modules, event.HasModule)
+}
+
+// EntryError is the error of fetching the timetable of a single entry of a
+// study plan.
+type EntryError struct {
+	Entry PlanEntry
+	Err   error
+}
+
+func (e *EntryError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Entry, e.Err)
+}
+
+func (e *EntryError) Unwrap() error {
+	return e.Err
+}
+
+// StudyPlan is the personal study plan of a student, who can attend
+// teachings of different years, curricula and even degrees.
+//
+//	plan := degree.StudyPlan{Entries: []degree.PlanEntry{
+//		{Degree: degree.ID{Type: "laurea", Id: "informatica"}, Year: 2, Modules: []string{"72677"}},
+//		{Degree: degree.ID{Type: "laurea", Id: "informatica"}, Year: 3, Modules: []string{"00819"}},
+//	}}
+//	t, err := plan.FetchTimetable(ctx, nil, 0)
+type StudyPlan struct {
+	Entries []PlanEntry `json:"entries"`
+}
+
+// FetchTimetable returns the personal timetable of the study plan: the
+// lessons of the modules of each entry, without duplicates and sorted by
+// start time. The timetables are fetched making at most concurrency
+// requests at a time (DefaultConcurrency if not positive), and interval is
+// passed to timetable.FetchTimetable.
+//
+// A failed entry does not stop the others: the returned timetable holds the
+// lessons of the entries fetched successfully, and the error joins an
+// *EntryError for each failed entry, in the order of the entries.
+func (p StudyPlan) FetchTimetable(ctx context.Context, interval *timetable.Interval, concurrency int) (timetable.Timetable, error) {
+	return DefaultClient.FetchStudyPlanTimetable(ctx, p, interval, concurrency)
+}
+
+// FetchStudyPlanTimetable is like StudyPlan.FetchTimetable, but the requests
+// are made with the timetable client of the client.
+func (c *Client) FetchStudyPlanTimetable(ctx context.Context, p StudyPlan, interval *timetable.Interval, concurrency int) (timetable.Timetable, error) {
+	limit := newLimiter(concurrency)
+
+	var (
+		wg         sync.WaitGroup
+		timetables = make([]timetable.Timetable, len(p.Entries))
+		errs       = make([]error, len(p.Entries)) // By entry, so that they are joined in order
+	)
+
+	for i, entry := range p.Entries {
+		wg.Go(func() {
+			t, err := c.fetchEntryTimetable(ctx, entry, limit, interval)
+			if err != nil {
+				errs[i] = &EntryError{Entry: entry, Err: err}
+				return
+			}
+			timetables[i] = t
+		})
+	}
+	wg.Wait()
+
+	return timetable.Dedupe(timetable.Merge(timetables...)), errors.Join(errs...)
+}
+
+func (c *Client) fetchEntryTimetable(ctx context.Context, e PlanEntry, limit limiter, interval *timetable.Interval) (timetable.Timetable, error) {
+	err := limit.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer limit.release()
+
+	t, err := c.timetable().FetchTimetable(ctx, e.Degree.Type, e.Degree.Id, e.Curriculum, e.Year, interval)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(t, func(event timetable.Event) bool { return !e.includes(event) }), nil
+}
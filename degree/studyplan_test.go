@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package degree
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveTimetables returns a Client querying a test server, serving the
+// timetables of the years 2 and 3 of "informatica".
+func serveTimetables(t *testing.T) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/laurea/informatica/orario-lezioni/@@orario_reale_json" {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.URL.Query().Get("anno") {
+		case "2":
+			_, _ = w.Write([]byte(`[
+				{"cod_modulo": "72677", "title": "Sistemi operativi", "start": "2024-10-07T09:00:00", "end": "2024-10-07T11:00:00"},
+				{"cod_modulo": "11111", "title": "Altro", "start": "2024-10-07T11:00:00", "end": "2024-10-07T13:00:00"},
+				{"cod_modulo": "00819_2", "title": "Comune, modulo 2", "start": "2024-10-08T09:00:00", "end": "2024-10-08T11:00:00"}
+			]`))
+		case "3":
+			_, _ = w.Write([]byte(`[
+				{"cod_modulo": "00819_1", "title": "Comune, modulo 1", "start": "2024-10-07T14:00:00", "end": "2024-10-07T16:00:00"},
+				{"cod_modulo": "00819_2", "title": "Comune, modulo 2", "start": "2024-10-08T09:00:00", "end": "2024-10-08T11:00:00"}
+			]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return NewClient(unibo.WithBaseURL(server.URL))
+}
+
+func TestStudyPlanFetchTimetable(t *testing.T) {
+	client := serveTimetables(t)
+
+	informatica := ID{Type: "laurea", Id: "informatica"}
+	plan := StudyPlan{Entries: []PlanEntry{
+		{Degree: informatica, Year: 3, Modules: []string{"00819"}},
+		{Degree: informatica, Year: 2, Modules: []string{"72677", "00819_2"}},
+	}}
+
+	tt, err := client.FetchStudyPlanTimetable(context.Background(), plan, nil, 1)
+	require.NoError(t, err)
+
+	var codes []string
+	for _, e := range tt {
+		codes = append(codes, e.CodModulo)
+	}
+	assert.Equal(t, []string{"72677", "00819_1", "00819_2"}, codes, "the lessons should be filtered, sorted and deduplicated")
+}
+
+func TestStudyPlanFetchTimetableErrors(t *testing.T) {
+	client := serveTimetables(t)
+
+	plan := StudyPlan{Entries: []PlanEntry{
+		{Degree: ID{Type: "laurea", Id: "informatica"}, Year: 2},
+		{Degree: ID{Type: "laurea", Id: "informatica"}, Year: 5},
+	}}
+
+	tt, err := client.FetchStudyPlanTimetable(context.Background(), plan, nil, 0)
+	assert.Len(t, tt, 3, "the lessons of the other entries should be returned")
+
+	var entryErr *EntryError
+	require.ErrorAs(t, err, &entryErr)
+	assert.Equal(t, 5, entryErr.Entry.Year)
+}
+
+func TestStudyPlanFetchTimetableErrorOrder(t *testing.T) {
+	// Every entry fails, the later ones sooner
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		year, _ := strconv.Atoi(r.URL.Query().Get("anno"))
+		time.Sleep(time.Duration(5-year) * 5 * time.Millisecond)
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	informatica := ID{Type: "laurea", Id: "informatica"}
+	plan := StudyPlan{Entries: []PlanEntry{
+		{Degree: informatica, Year: 1},
+		{Degree: informatica, Year: 2},
+		{Degree: informatica, Year: 3},
+		{Degree: informatica, Year: 4},
+	}}
+
+	_, err := NewClient(unibo.WithBaseURL(server.URL)).FetchStudyPlanTimetable(context.Background(), plan, nil, 4)
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	require.True(t, ok, "expected joined errors")
+	var years []int
+	for _, err := range joined.Unwrap() {
+		var entryErr *EntryError
+		require.ErrorAs(t, err, &entryErr)
+		years = append(years, entryErr.Entry.Year)
+	}
+	assert.Equal(t, []int{1, 2, 3, 4}, years, "the errors should be in the order of the entries")
+}
@@ -6,10 +6,12 @@
 package department
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"regexp"
+
+	"github.com/cartabinaria/unibo-go"
 )
 
 // Department represents a department of the university.
@@ -22,7 +24,40 @@ func (d Department) Url() string                       { return "https://" + d.C
 func (d Department) FetchTeachers() ([]Teacher, error) { return FetchTeachers(d.Code) }
 func (d Department) GetTeachersUrl() string            { return getDepartmentTeacherUrl(d.Code) }
 
-const departmentsUrl = "https://www.unibo.it/it/ateneo/sedi-e-strutture/dipartimenti"
+func (d Department) FetchTeachersContext(ctx context.Context) ([]Teacher, error) {
+	return FetchTeachersContext(ctx, d.Code)
+}
+
+const (
+	baseUrl         = "https://www.unibo.it"
+	departmentsPath = "/it/ateneo/sedi-e-strutture/dipartimenti"
+)
+
+// Client fetches departments and teachers from the University website.
+//
+// The zero value uses unibo.DefaultClient.
+type Client struct {
+	client *unibo.Client
+}
+
+// NewClient creates a new department Client configured with the given options.
+//
+// unibo.WithBaseURL replaces "https://www.unibo.it" for the list of departments.
+// Department websites live on their own subdomain, so their pages are fetched
+// from the base URL followed by the department code, e.g. "<base>/disi/it/...".
+func NewClient(opts ...unibo.Option) *Client {
+	return &Client{client: unibo.NewClient(opts...)}
+}
+
+// DefaultClient is the Client used by the package-level functions.
+var DefaultClient = &Client{}
+
+func (c *Client) http() *unibo.Client {
+	if c == nil || c.client == nil {
+		return unibo.DefaultClient
+	}
+	return c.client
+}
 
 var departmentsRegex = regexp.MustCompile("<a class=\"internal-link\" href=\"https://(.+).unibo.it/it\".+>(.+)</a>")
 
@@ -31,7 +66,17 @@ var departmentsRegex = regexp.MustCompile("<a class=\"internal-link\" href=\"htt
 // It gets the list from the university website via HTTP and then applies a regex
 // to parse the HTML.
 func FetchDepartments() ([]Department, error) {
-	res, err := http.Get(departmentsUrl)
+	return FetchDepartmentsContext(context.Background())
+}
+
+// FetchDepartmentsContext is like FetchDepartments, but the request is bound to ctx.
+func FetchDepartmentsContext(ctx context.Context) ([]Department, error) {
+	return DefaultClient.FetchDepartments(ctx)
+}
+
+// FetchDepartments retrieves the list of departments of the university.
+func (c *Client) FetchDepartments(ctx context.Context) ([]Department, error) {
+	res, err := c.http().Get(ctx, c.http().BaseURL(baseUrl)+departmentsPath)
 	if err != nil {
 		return nil, err
 	}
@@ -63,11 +108,20 @@ func FetchDepartments() ([]Department, error) {
 }
 
 const (
-	maxTeachers = "2000"
-	teachersUrl = "https://%s.unibo.it/it/dipartimento/persone/docenti-e-ricercatori"
+	maxTeachers  = "2000"
+	teachersUrl  = "https://%s.unibo.it/it/dipartimento/persone/docenti-e-ricercatori"
+	teachersPath = "/%s/it/dipartimento/persone/docenti-e-ricercatori"
 )
 
 // getDepartmentTeacherUrl returns the URL to fetch the list of teachers for the given department.
 func getDepartmentTeacherUrl(department string) string {
-	return fmt.Sprintf(teachersUrl, department) + "?pagesize=" + maxTeachers
+	return DefaultClient.teachersUrl(department)
+}
+
+func (c *Client) teachersUrl(department string) string {
+	format := teachersUrl
+	if base := c.http().BaseURL(""); base != "" {
+		format = base + teachersPath
+	}
+	return fmt.Sprintf(format, department) + "?pagesize=" + maxTeachers
 }
@@ -5,8 +5,8 @@
 package department
 
 import (
+	"context"
 	"io"
-	"net/http"
 	"regexp"
 )
 
@@ -22,9 +22,19 @@ var teachersRegex = regexp.MustCompile("<a href=\"https://www.unibo.it/sitoweb/(
 
 // FetchTeachers retrieves the list of teachers for the given department.
 func FetchTeachers(departmentCode string) ([]Teacher, error) {
-	url := getDepartmentTeacherUrl(departmentCode)
+	return FetchTeachersContext(context.Background(), departmentCode)
+}
+
+// FetchTeachersContext is like FetchTeachers, but the request is bound to ctx.
+func FetchTeachersContext(ctx context.Context, departmentCode string) ([]Teacher, error) {
+	return DefaultClient.FetchTeachers(ctx, departmentCode)
+}
+
+// FetchTeachers retrieves the list of teachers for the given department.
+func (c *Client) FetchTeachers(ctx context.Context, departmentCode string) ([]Teacher, error) {
+	url := c.teachersUrl(departmentCode)
 
-	res, err := http.Get(url)
+	res, err := c.http().Get(ctx, url)
 	if err != nil {
 		return nil, err
 	}
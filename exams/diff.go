@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package exams
+
+import (
+	"slices"
+)
+
+// Key returns a stable identifier of the exam, made of its subject code, its
+// type and its date, e.g. "72677-prova-scritta-20241210T0900".
+func (e Exam) Key() string {
+	return e.SubjectCode + "-" + slug(e.Type) + "-" + e.Date.Format("20060102T1504")
+}
+
+// Field is a field of an exam that can change between two snapshots.
+type Field string
+
+const (
+	FieldSubject       Field = "subject"       // The name of the subject
+	FieldTeacher       Field = "teacher"       // The teacher
+	FieldLocation      Field = "location"      // The location
+	FieldSubscriptions Field = "subscriptions" // The subscription period
+)
+
+// Modification is an exam changed between two snapshots.
+type Modification struct {
+	Old, New Exam
+	Fields   []Field // The fields changed, in the order of the Field constants
+}
+
+// Changes are the differences between two snapshots of the exams.
+type Changes struct {
+	Added    []Exam         // The exams only in the new snapshot, e.g. new appelli
+	Removed  []Exam         // The exams only in the old snapshot
+	Modified []Modification // The exams in both snapshots, with different fields
+}
+
+// IsEmpty reports whether there are no changes.
+func (c Changes) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Modified) == 0
+}
+
+// Diff returns the changes from the old to the new snapshot of the exams,
+// sorted by date.
+//
+// Exams are matched by Key, so an exam moved to another date is removed and
+// added.
+func Diff(old, new []Exam) Changes {
+	var changes Changes
+
+	newByKey := make(map[string]Exam, len(new))
+	for _, e := range new {
+		newByKey[e.Key()] = e
+	}
+	seen := make(map[string]bool, len(old)) // The keys already considered, to skip duplicated exams
+	for _, o := range old {
+		key := o.Key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		n, ok := newByKey[key]
+		if !ok {
+			changes.Removed = append(changes.Removed, o)
+			continue
+		}
+		if fields := changedFields(o, n); len(fields) > 0 {
+			changes.Modified = append(changes.Modified, Modification{Old: o, New: n, Fields: fields})
+		}
+	}
+	for _, n := range new {
+		key := n.Key()
+		if !seen[key] {
+			seen[key] = true
+			changes.Added = append(changes.Added, n)
+		}
+	}
+
+	byDate := func(a, b Exam) int { return a.Date.Compare(b.Date) }
+	slices.SortStableFunc(changes.Added, byDate)
+	slices.SortStableFunc(changes.Removed, byDate)
+	slices.SortStableFunc(changes.Modified, func(a, b Modification) int { return byDate(a.New, b.New) })
+	return changes
+}
+
+func changedFields(o, n Exam) []Field {
+	var fields []Field
+	if o.SubjectName != n.SubjectName {
+		fields = append(fields, FieldSubject)
+	}
+	if o.Teacher != n.Teacher {
+		fields = append(fields, FieldTeacher)
+	}
+	if o.Location != n.Location {
+		fields = append(fields, FieldLocation)
+	}
+	if o.Subscriptions != n.Subscriptions {
+		fields = append(fields, FieldSubscriptions)
+	}
+	return fields
+}
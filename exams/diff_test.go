@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package exams
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	timezone, err := time.LoadLocation("Europe/Rome")
+	require.NoError(t, err)
+	date := func(day int) time.Time { return time.Date(2024, time.December, day, 9, 0, 0, 0, timezone) }
+
+	written := Exam{SubjectCode: "72677", SubjectName: "Sistemi operativi", Type: "Prova scritta", Date: date(10), Location: "Aula 1"}
+	oral := Exam{SubjectCode: "72677", SubjectName: "Sistemi operativi", Type: "Prova orale", Date: date(10), Location: "Studio"}
+	moved := Exam{SubjectCode: "00819", SubjectName: "Programmazione", Type: "Prova scritta", Date: date(12)}
+
+	newLocation := written
+	newLocation.Location = "Aula 2"
+	newLocation.Subscriptions = "aperta dal 18 ottobre 2024 al 05 dicembre 2024"
+	movedLater := moved
+	movedLater.Date = date(19)
+	added := Exam{SubjectCode: "11929", SubjectName: "Basi di dati", Type: "Prova scritta", Date: date(5)}
+
+	changes := Diff([]Exam{written, oral, moved}, []Exam{movedLater, oral, newLocation, added, added})
+
+	require.Len(t, changes.Added, 2)
+	assert.Equal(t, "11929", changes.Added[0].SubjectCode, "the added exams should be sorted by date")
+	assert.Equal(t, date(19), changes.Added[1].Date, "a moved exam should be added with the new date")
+
+	require.Len(t, changes.Removed, 1)
+	assert.Equal(t, date(12), changes.Removed[0].Date, "a moved exam should be removed with the old date")
+
+	require.Len(t, changes.Modified, 1)
+	assert.Equal(t, "Aula 1", changes.Modified[0].Old.Location)
+	assert.Equal(t, "Aula 2", changes.Modified[0].New.Location)
+	assert.Equal(t, []Field{FieldLocation, FieldSubscriptions}, changes.Modified[0].Fields)
+
+	assert.True(t, Diff([]Exam{written, oral}, []Exam{oral, written}).IsEmpty(), "the order should not matter")
+}
+
+func TestDiffSnapshot(t *testing.T) {
+	timezone, err := time.LoadLocation("Europe/Rome")
+	require.NoError(t, err)
+	exams := []Exam{{SubjectCode: "72677", Type: "Prova scritta", Date: time.Date(2024, time.December, 10, 9, 0, 0, 0, timezone)}}
+
+	// The keys should not change after a round trip, e.g. through a snapshot
+	buf, err := json.Marshal(exams)
+	require.NoError(t, err)
+	var decoded []Exam
+	require.NoError(t, json.Unmarshal(buf, &decoded))
+
+	assert.Equal(t, exams[0].Key(), decoded[0].Key())
+	assert.True(t, Diff(exams, decoded).IsEmpty())
+}
@@ -6,21 +6,58 @@
 package exams
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"net/http"
 	"os"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/antchfx/htmlquery"
+
+	"github.com/cartabinaria/unibo-go"
 )
 
 var (
 	baseUrl = "https://corsi.unibo.it/%s/%s/appelli"
 )
 
+const appelliPath = "/%s/%s/appelli"
+
+// Client fetches exam sessions from the University website.
+//
+// The zero value uses unibo.DefaultClient.
+type Client struct {
+	client *unibo.Client
+}
+
+// NewClient creates a new exams Client configured with the given options.
+//
+// unibo.WithBaseURL replaces "https://corsi.unibo.it".
+func NewClient(opts ...unibo.Option) *Client {
+	return &Client{client: unibo.NewClient(opts...)}
+}
+
+// DefaultClient is the Client used by the package-level functions.
+var DefaultClient = &Client{}
+
+func (c *Client) http() *unibo.Client {
+	if c == nil || c.client == nil {
+		return unibo.DefaultClient
+	}
+	return c.client
+}
+
+// appelliUrl returns the format string of the exams page, taking
+// unibo.WithBaseURL into account.
+func (c *Client) appelliUrl() string {
+	if base := c.http().BaseURL(""); base != "" {
+		return base + appelliPath
+	}
+	return baseUrl
+}
+
 type Exam struct {
 	SubjectCode   string
 	SubjectName   string
@@ -34,18 +71,40 @@ type Exam struct {
 var duplicatedSpaceRemover = regexp.MustCompile(`\s+`)
 
 func GetExams(courseType, courseId string) ([]Exam, error) {
-	return GetExamsForSubject(courseType, courseId, "")
+	return GetExamsContext(context.Background(), courseType, courseId)
+}
+
+// GetExamsContext is like GetExams, but the requests are bound to ctx.
+func GetExamsContext(ctx context.Context, courseType, courseId string) ([]Exam, error) {
+	return DefaultClient.GetExams(ctx, courseType, courseId)
+}
+
+// GetExams returns all the exams of the given course.
+func (c *Client) GetExams(ctx context.Context, courseType, courseId string) ([]Exam, error) {
+	return c.GetExamsForSubject(ctx, courseType, courseId, "")
 }
 
 // subjectsPerPage is the number of subjects that are shown per page on the website
 const subjectsPerPage = 20
 
 func GetExamsForSubject(courseType, courseId, subjectName string) ([]Exam, error) {
+	return GetExamsForSubjectContext(context.Background(), courseType, courseId, subjectName)
+}
+
+// GetExamsForSubjectContext is like GetExamsForSubject, but the requests are
+// bound to ctx.
+func GetExamsForSubjectContext(ctx context.Context, courseType, courseId, subjectName string) ([]Exam, error) {
+	return DefaultClient.GetExamsForSubject(ctx, courseType, courseId, subjectName)
+}
+
+// GetExamsForSubject returns the exams of the given course whose subject
+// matches subjectName. If subjectName is empty, all the exams are returned.
+func (c *Client) GetExamsForSubject(ctx context.Context, courseType, courseId, subjectName string) ([]Exam, error) {
 	var exams []Exam
 
 	start := 0
 	for {
-		url := fmt.Sprintf(baseUrl, courseType, courseId)
+		url := fmt.Sprintf(c.appelliUrl(), courseType, courseId)
 
 		// if we are looking for a specific subject, we need to specify the appelli parameter
 		if subjectName != "" {
@@ -57,7 +116,7 @@ func GetExamsForSubject(courseType, courseId, subjectName string) ([]Exam, error
 			url = fmt.Sprintf("%s?b_start:int=%d", url, start)
 		}
 
-		document, err := http.Get(url)
+		document, err := c.http().Get(ctx, url)
 		if err != nil {
 			return nil, fmt.Errorf("unable to fetch exams from url %s: %w", url, err)
 		}
@@ -121,3 +121,32 @@ func TestGetExams(t *testing.T) {
 	assert.Equal(t, "ONLINE", exams[1].Location)
 
 }
+
+func TestCalendar(t *testing.T) {
+	timezone, err := time.LoadLocation("Europe/Rome")
+	assert.NoError(t, err)
+
+	e := []Exam{{
+		SubjectCode:   "72677",
+		SubjectName:   "ANALISI DELLE RETI SOCIALI APPLICATA AD INTERNET",
+		Teacher:       "GIALLORENZO SAVERIO",
+		Date:          time.Date(2024, time.December, 06, 9, 0, 0, 0, timezone),
+		Type:          "Scritto e orale",
+		Location:      "ONLINE",
+		Subscriptions: "aperta dal 18 ottobre 2024 al 05 dicembre 2024",
+	}}
+
+	cal := Calendar(e, CalendarOptions{Duration: 2 * time.Hour, Reminders: []time.Duration{24 * time.Hour}})
+	assert.Len(t, cal.Events, 1)
+
+	event := cal.Events[0]
+	assert.Equal(t, "72677-scritto-e-orale-20241206T0900@corsi.unibo.it", event.UID)
+	assert.Equal(t, "ANALISI DELLE RETI SOCIALI APPLICATA AD INTERNET (Scritto e orale)", event.Summary)
+	assert.Equal(t, "ONLINE", event.Location)
+	assert.Equal(t, time.Date(2024, time.December, 06, 11, 0, 0, 0, timezone), event.End)
+	assert.Len(t, event.Alarms, 1)
+
+	// The UID must not depend on anything but the key of the exam
+	e[0].Location = "AULA 1"
+	assert.Equal(t, event.UID, Calendar(e, CalendarOptions{}).Events[0].UID)
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package exams
+
+import (
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cartabinaria/unibo-go/ical"
+)
+
+// uidDomain is the right-hand side of the UIDs of the exported events.
+const uidDomain = "corsi.unibo.it"
+
+// CalendarOptions configures the conversion of exams to an iCalendar.
+type CalendarOptions struct {
+	// Duration is the expected duration of each exam, since the website does
+	// not provide it. If zero, the events have no end.
+	Duration time.Duration
+	// Reminders adds an alarm for each duration, triggering that long before the exam.
+	Reminders []time.Duration
+}
+
+// Calendar converts the exams to an iCalendar, with one event per exam.
+//
+// The UID of each event is derived from the subject code, the type and the
+// date of the exam, so exporting the same exams twice yields the same UIDs and
+// calendar applications update the events instead of duplicating them.
+func Calendar(exams []Exam, opts CalendarOptions) *ical.Calendar {
+	events := make([]ical.Event, 0, len(exams))
+	for _, e := range exams {
+		events = append(events, e.calendarEvent(opts))
+	}
+	return &ical.Calendar{Events: events}
+}
+
+// WriteICS writes the exams to w in the iCalendar format.
+//
+// See Calendar for more information.
+func WriteICS(w io.Writer, exams []Exam, opts CalendarOptions) error {
+	return Calendar(exams, opts).Encode(w)
+}
+
+func (e Exam) calendarEvent(opts CalendarOptions) ical.Event {
+	summary := e.SubjectName
+	if e.Type != "" {
+		summary += " (" + e.Type + ")"
+	}
+
+	var description []string
+	if e.Teacher != "" {
+		description = append(description, "Docente: "+e.Teacher)
+	}
+	if e.Subscriptions != "" {
+		description = append(description, "Lista iscrizioni: "+e.Subscriptions)
+	}
+
+	var end time.Time
+	if opts.Duration > 0 {
+		end = e.Date.Add(opts.Duration)
+	}
+
+	var categories []string
+	if e.Type != "" {
+		categories = []string{e.Type}
+	}
+
+	alarms := make([]ical.Alarm, 0, len(opts.Reminders))
+	for _, before := range opts.Reminders {
+		alarms = append(alarms, ical.Alarm{Before: before})
+	}
+
+	return ical.Event{
+		UID:         e.uid(),
+		Start:       e.Date,
+		End:         end,
+		Summary:     summary,
+		Location:    e.Location,
+		Description: strings.Join(description, "\n"),
+		Categories:  categories,
+		Alarms:      alarms,
+	}
+}
+
+// uid returns a stable identifier of the exam, suitable for the UID property.
+func (e Exam) uid() string {
+	return e.Key() + "@" + uidDomain
+}
+
+// slug lowercases s and replaces every run of non-alphanumeric characters with a dash.
+func slug(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
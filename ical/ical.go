@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+// Package ical provides a minimal iCalendar (RFC 5545) encoder, used to export
+// timetables and exams to calendar applications.
+//
+// All the dates are written in the Europe/Rome timezone, which is the one used
+// by every UniBo service. The calendar embeds the matching VTIMEZONE component.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	defaultProdID = "-//cartabinaria//unibo-go//EN"
+	ianaTimezone  = "Europe/Rome"
+
+	dateTimeLayout = "20060102T150405"  // Local date-time, used together with TZID
+	utcLayout      = "20060102T150405Z" // UTC date-time, used for DTSTAMP
+
+	maxLineLength = 75 // Maximum length of a content line in octets, excluding CRLF
+)
+
+// vtimezone describes the Europe/Rome timezone rules (CET/CEST).
+const vtimezone = `BEGIN:VTIMEZONE
+TZID:Europe/Rome
+BEGIN:DAYLIGHT
+TZOFFSETFROM:+0100
+TZOFFSETTO:+0200
+TZNAME:CEST
+DTSTART:19700329T020000
+RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU
+END:DAYLIGHT
+BEGIN:STANDARD
+TZOFFSETFROM:+0200
+TZOFFSETTO:+0100
+TZNAME:CET
+DTSTART:19701025T030000
+RRULE:FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU
+END:STANDARD
+END:VTIMEZONE`
+
+// Calendar represents a VCALENDAR object.
+type Calendar struct {
+	ProdID    string    // The product identifier. If empty, a default one is used.
+	Name      string    // The name of the calendar (X-WR-CALNAME). Can be empty.
+	Timestamp time.Time // The DTSTAMP of every event. If zero, the current time is used.
+	Events    []Event   // The events of the calendar
+}
+
+// Event represents a VEVENT component.
+type Event struct {
+	UID         string    // A globally unique and stable identifier of the event
+	Start       time.Time // The start of the event
+	End         time.Time // The end of the event. If zero, DTEND is omitted.
+	Summary     string    // A short summary of the event, e.g. its title
+	Location    string    // The location of the event. Can be empty.
+	Description string    // A longer description of the event. Can be empty.
+	URL         string    // A link related to the event. Can be empty.
+	Categories  []string  // The categories of the event. Can be empty.
+	Alarms      []Alarm   // The reminders of the event. Can be empty.
+}
+
+// Alarm represents a VALARM component that displays a reminder before the
+// start of an event.
+type Alarm struct {
+	Before      time.Duration // How long before the start of the event the alarm triggers
+	Description string        // The text to display. If empty, the summary of the event is used.
+}
+
+// Encode writes the calendar to w in the iCalendar format.
+func (c *Calendar) Encode(w io.Writer) error {
+	timezone, err := time.LoadLocation(ianaTimezone)
+	if err != nil {
+		return fmt.Errorf("could not load italian timezone: %w", err)
+	}
+
+	prodID := c.ProdID
+	if prodID == "" {
+		prodID = defaultProdID
+	}
+
+	stamp := c.Timestamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	dtstamp := stamp.UTC().Format(utcLayout)
+
+	bw := bufio.NewWriter(w)
+	e := &encoder{w: bw}
+
+	e.line("BEGIN:VCALENDAR")
+	e.line("VERSION:2.0")
+	e.line("PRODID:" + prodID)
+	e.line("CALSCALE:GREGORIAN")
+	e.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		e.line("X-WR-CALNAME:" + escape(c.Name))
+		e.line("X-WR-TIMEZONE:" + ianaTimezone)
+	}
+	for _, l := range strings.Split(vtimezone, "\n") {
+		e.line(l)
+	}
+
+	for _, ev := range c.Events {
+		e.line("BEGIN:VEVENT")
+		e.line("UID:" + ev.UID)
+		e.line("DTSTAMP:" + dtstamp)
+		e.line("DTSTART;TZID=" + ianaTimezone + ":" + ev.Start.In(timezone).Format(dateTimeLayout))
+		if !ev.End.IsZero() {
+			e.line("DTEND;TZID=" + ianaTimezone + ":" + ev.End.In(timezone).Format(dateTimeLayout))
+		}
+		e.line("SUMMARY:" + escape(ev.Summary))
+		if ev.Location != "" {
+			e.line("LOCATION:" + escape(ev.Location))
+		}
+		if ev.Description != "" {
+			e.line("DESCRIPTION:" + escape(ev.Description))
+		}
+		if ev.URL != "" {
+			e.line("URL:" + ev.URL)
+		}
+		if len(ev.Categories) > 0 {
+			categories := make([]string, len(ev.Categories))
+			for i, category := range ev.Categories {
+				categories[i] = escape(category)
+			}
+			e.line("CATEGORIES:" + strings.Join(categories, ","))
+		}
+		for _, alarm := range ev.Alarms {
+			description := alarm.Description
+			if description == "" {
+				description = ev.Summary
+			}
+			e.line("BEGIN:VALARM")
+			e.line("ACTION:DISPLAY")
+			e.line("DESCRIPTION:" + escape(description))
+			e.line("TRIGGER:" + formatTrigger(alarm.Before))
+			e.line("END:VALARM")
+		}
+		e.line("END:VEVENT")
+	}
+
+	e.line("END:VCALENDAR")
+
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+// encoder writes content lines, remembering the first error encountered.
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line writes a content line, folding it as required by RFC 5545 section 3.1.
+func (e *encoder) line(s string) {
+	if e.err != nil {
+		return
+	}
+
+	first := true
+	for len(s) > 0 {
+		limit := maxLineLength
+		if !first {
+			limit-- // Continuation lines start with a space
+		}
+
+		n := len(s)
+		if n > limit {
+			// Do not split a multi-byte character
+			n = limit
+			for n > 0 && !utf8.RuneStart(s[n]) {
+				n--
+			}
+		}
+
+		if !first {
+			_, e.err = e.w.WriteString(" ")
+		}
+		if e.err == nil {
+			_, e.err = e.w.WriteString(s[:n] + "\r\n")
+		}
+		if e.err != nil {
+			return
+		}
+
+		s = s[n:]
+		first = false
+	}
+}
+
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// escape escapes a TEXT value as described in RFC 5545 section 3.3.11.
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// formatTrigger returns a negative DURATION value (RFC 5545 section 3.3.6)
+// for an alarm that triggers d before the start of an event.
+func formatTrigger(d time.Duration) string {
+	if d < 0 {
+		d = -d
+	}
+
+	d = d.Truncate(time.Minute)
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+	hours := d / time.Hour
+	d -= hours * time.Hour
+	minutes := d / time.Minute
+
+	var b strings.Builder
+	b.WriteString("-P")
+	if days > 0 {
+		fmt.Fprintf(&b, "%dD", days)
+	}
+	if hours > 0 || minutes > 0 || days == 0 {
+		b.WriteString("T")
+		if hours > 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes > 0 || hours == 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+	}
+	return b.String()
+}
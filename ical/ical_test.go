@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	timezone, err := time.LoadLocation("Europe/Rome")
+	require.NoError(t, err)
+
+	cal := Calendar{
+		Name:      "Orario",
+		Timestamp: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Events: []Event{{
+			UID:         "28004_1--A-K-20230919T0900@corsi.unibo.it",
+			Start:       time.Date(2023, time.September, 19, 9, 0, 0, 0, timezone),
+			End:         time.Date(2023, time.September, 19, 12, 0, 0, 0, timezone),
+			Summary:     "FONDAMENTI DI INFORMATICA T-1, Modulo 1",
+			Location:    "AULA 6.2; Viale del Risorgimento",
+			Description: "Docente: Paola Mello\nCFU: 12",
+			Alarms:      []Alarm{{Before: 24 * time.Hour}, {Before: 90 * time.Minute}},
+		}},
+	}
+
+	var b strings.Builder
+	require.NoError(t, cal.Encode(&b))
+	out := b.String()
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.Contains(t, out, "TZID:Europe/Rome\r\n")
+	assert.Contains(t, out, "X-WR-CALNAME:Orario\r\n")
+	assert.Contains(t, out, "DTSTAMP:20240101T000000Z\r\n")
+	assert.Contains(t, out, "DTSTART;TZID=Europe/Rome:20230919T090000\r\n")
+	assert.Contains(t, out, "DTEND;TZID=Europe/Rome:20230919T120000\r\n")
+	assert.Contains(t, out, "SUMMARY:FONDAMENTI DI INFORMATICA T-1\\, Modulo 1\r\n")
+	assert.Contains(t, out, "LOCATION:AULA 6.2\\; Viale del Risorgimento\r\n")
+	assert.Contains(t, out, "DESCRIPTION:Docente: Paola Mello\\nCFU: 12\r\n")
+	assert.Contains(t, out, "TRIGGER:-P1D\r\n")
+	assert.Contains(t, out, "TRIGGER:-PT1H30M\r\n")
+}
+
+func TestEncodeFoldsLongLines(t *testing.T) {
+	cal := Calendar{Events: []Event{{
+		UID:     "long",
+		Start:   time.Now(),
+		Summary: strings.Repeat("è", 100),
+	}}}
+
+	var b strings.Builder
+	require.NoError(t, cal.Encode(&b))
+
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, "line too long: %q", line)
+		assert.True(t, strings.ToValidUTF8(line, "") == line, "line split inside a character: %q", line)
+	}
+}
+
+func TestFormatTrigger(t *testing.T) {
+	assert.Equal(t, "-PT15M", formatTrigger(15*time.Minute))
+	assert.Equal(t, "-PT2H", formatTrigger(2*time.Hour))
+	assert.Equal(t, "-P2DT3H", formatTrigger(51*time.Hour))
+	assert.Equal(t, "-PT0M", formatTrigger(0))
+}
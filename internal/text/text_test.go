@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "forli via zamboni 33", Normalize("  Forlì, Via Zamboni; 33 "))
+	assert.Equal(t, "scienze dell alimentazione", Normalize("Scienze dell'ALIMENTAZIONE"))
+	assert.Empty(t, Normalize(" - "))
+}
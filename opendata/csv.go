@@ -0,0 +1,262 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package opendata
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Warning describes a non-fatal problem found while decoding a CSV file,
+// e.g. a column that is not mapped to any field.
+type Warning struct {
+	Column  string // The name of the column
+	Message string // A description of the problem
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("column %q: %s", w.Column, w.Message)
+}
+
+// CSVDecoder reads the rows of a CSV file with a header into values of type
+// T, which must be a struct.
+//
+// Columns are mapped to the exported fields of T by the "csv" tag, which can
+// list alternative column names separated by "|", or by field name if the
+// tag is missing. A tag of "-" skips the field. Names are compared
+// case-insensitively, ignoring leading and trailing spaces.
+//
+//	type row struct {
+//		Code  string  `csv:"corso_codice"`
+//		Years int     `csv:"durata"`
+//		Fee   float64 `csv:"tassa|fee"`
+//	}
+//
+// Values are converted to the type of the field. Besides the usual Go syntax,
+// booleans accept the Italian "sì"/"si"/"no"/"vero"/"falso", and floats accept
+// a decimal comma, e.g. "1.234,5". Fields implementing encoding.TextUnmarshaler
+// are decoded with it. Empty values leave the field unchanged.
+//
+// Columns without a matching field, and fields without a matching column, are
+// reported in Warnings instead of failing: this way a dataset that gains or
+// loses a column keeps working. Two fields matching the same column are an
+// error instead, since one of them would silently stay empty.
+type CSVDecoder[T any] struct {
+	Warnings []Warning // The problems found in the header
+
+	reader  *csv.Reader
+	columns []int // The index of the field for each column, or -1 if the column is ignored
+	header  []string
+}
+
+// NewCSVDecoder returns a decoder reading from r, which must start with a
+// header row. The header is read immediately.
+func NewCSVDecoder[T any](r io.Reader) (*CSVDecoder[T], error) {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot decode into %s: not a struct", t)
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // Short or long rows are handled by Decode
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read header: %w", err)
+	}
+
+	d := &CSVDecoder[T]{reader: reader, columns: make([]int, len(header))}
+
+	// Index the columns by normalized name
+	byName := make(map[string]int, len(header))
+	for i, column := range header {
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff") // Byte order mark
+		}
+		column = strings.TrimSpace(column)
+		d.header = append(d.header, column)
+		d.columns[i] = -1
+		byName[strings.ToLower(column)] = i
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("csv")
+		if tag == "-" {
+			continue
+		} else if tag == "" {
+			tag = field.Name
+		}
+
+		found := false
+		for _, name := range strings.Split(tag, "|") {
+			if column, ok := byName[strings.ToLower(strings.TrimSpace(name))]; ok {
+				if d.columns[column] != -1 {
+					return nil, fmt.Errorf("cannot decode into %s: fields %s and %s both map to column %q",
+						t, t.Field(d.columns[column]).Name, field.Name, d.header[column])
+				}
+				d.columns[column] = i
+				found = true
+				break
+			}
+		}
+		if !found {
+			d.Warnings = append(d.Warnings, Warning{Column: tag, Message: "missing column for field " + field.Name})
+		}
+	}
+
+	for i, column := range d.header {
+		if d.columns[i] == -1 {
+			d.Warnings = append(d.Warnings, Warning{Column: column, Message: "unknown column"})
+		}
+	}
+
+	return d, nil
+}
+
+// Decode reads the next row. It returns io.EOF when there are no more rows.
+//
+// Missing trailing values are treated as empty, and extra values are ignored.
+func (d *CSVDecoder[T]) Decode() (T, error) {
+	var value T
+
+	row, err := d.reader.Read()
+	if err != nil {
+		return value, err
+	}
+
+	line, _ := d.reader.FieldPos(0)
+
+	v := reflect.ValueOf(&value).Elem()
+	for i, s := range row {
+		if i >= len(d.columns) || d.columns[i] == -1 {
+			continue
+		}
+
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		if err := setField(v.Field(d.columns[i]), s); err != nil {
+			return value, fmt.Errorf("line %d, column %q: %w", line, d.header[i], err)
+		}
+	}
+
+	return value, nil
+}
+
+// DecodeCSV reads all the rows of r, which must start with a header row.
+//
+// See CSVDecoder for how the columns are mapped to the fields of T.
+func DecodeCSV[T any](r io.Reader) ([]T, []Warning, error) {
+	d, err := NewCSVDecoder[T](r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var values []T
+	for {
+		value, err := d.Decode()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, d.Warnings, err
+		}
+		values = append(values, value)
+	}
+
+	return values, d.Warnings, nil
+}
+
+// ParseItalianBool parses a boolean, accepting the Italian words "sì", "si",
+// "no", "vero" and "falso" (in any case) besides the values accepted by
+// strconv.ParseBool.
+func ParseItalianBool(s string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "sì", "si", "s", "vero", "yes", "y":
+		return true, nil
+	case "no", "n", "falso":
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
+
+// ParseItalianFloat parses a number that can use a decimal comma and dots as
+// thousands separators, e.g. "1.234,5". Numbers without a comma are parsed
+// as usual, e.g. "1234.5".
+func ParseItalianFloat(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, ",") {
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.Replace(s, ",", ".", 1)
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// dateLayouts are the layouts accepted for time.Time fields.
+var dateLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02", "02/01/2006"}
+
+func setField(dst reflect.Value, s string) error {
+	// Before the TextUnmarshaler check, since time.Time implements it but
+	// only accepts RFC 3339
+	if dst.Type() == reflect.TypeFor[time.Time]() {
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				dst.Set(reflect.ValueOf(t))
+				return nil
+			}
+		}
+		return fmt.Errorf("cannot parse date %q", s)
+	}
+
+	if u, ok := dst.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		dst.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, dst.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dst.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := ParseItalianFloat(s)
+		if err != nil {
+			return err
+		}
+		dst.SetFloat(f)
+	case reflect.Bool:
+		b, err := ParseItalianBool(s)
+		if err != nil {
+			return err
+		}
+		dst.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", dst.Type())
+	}
+	return nil
+}
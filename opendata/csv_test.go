@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package opendata
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRow struct {
+	Code     string  `csv:"corso_codice"`
+	Years    int     `csv:"durata"`
+	Fee      float64 `csv:"tassa|fee"`
+	Open     bool    `csv:"immatricolabile"`
+	Name     string
+	Ignored  string `csv:"-"`
+	Missing  string `csv:"mancante"`
+	internal string
+}
+
+func TestDecodeCSV(t *testing.T) {
+	input := "\ufeffCORSO_CODICE,durata, fee ,immatricolabile,name,extra\n" +
+		"8009,3,\"1.234,5\",SI,Informatica,x\n" +
+		"9254,2,12.5,no,Ingegneria\n"
+
+	rows, warnings, err := DecodeCSV[testRow](strings.NewReader(input))
+	require.NoError(t, err, "DecodeCSV should not return an error")
+
+	assert.Equal(t, []testRow{
+		{Code: "8009", Years: 3, Fee: 1234.5, Open: true, Name: "Informatica"},
+		{Code: "9254", Years: 2, Fee: 12.5, Open: false, Name: "Ingegneria"},
+	}, rows, "rows should be decoded by header")
+
+	assert.Equal(t, []Warning{
+		{Column: "mancante", Message: "missing column for field Missing"},
+		{Column: "extra", Message: "unknown column"},
+	}, warnings, "missing and unknown columns should be reported")
+}
+
+func TestDecodeCSVError(t *testing.T) {
+	input := "corso_codice,durata\n8009,3\n9254,tre\n"
+
+	_, _, err := DecodeCSV[testRow](strings.NewReader(input))
+	require.Error(t, err, "DecodeCSV should fail on an invalid value")
+	assert.Contains(t, err.Error(), `line 3, column "durata"`, "error should report the position")
+}
+
+func TestDecodeCSVNotStruct(t *testing.T) {
+	_, _, err := DecodeCSV[string](strings.NewReader("a\n"))
+	assert.Error(t, err, "DecodeCSV should only decode into structs")
+}
+
+func TestDecodeCSVDuplicateColumn(t *testing.T) {
+	type row struct {
+		Name  string `csv:"nome"`
+		Title string `csv:"titolo|nome"`
+	}
+
+	_, err := NewCSVDecoder[row](strings.NewReader("nome\nInformatica\n"))
+	require.Error(t, err, "NewCSVDecoder should fail when two fields map to the same column")
+	assert.Contains(t, err.Error(), `fields Name and Title both map to column "nome"`)
+}
+
+func TestDecodeCSVDates(t *testing.T) {
+	type row struct {
+		Date time.Time `csv:"data"`
+	}
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2024-09-01T10:30:00+02:00", time.Date(2024, time.September, 1, 10, 30, 0, 0, time.FixedZone("", 2*60*60))},
+		{"2024-09-01T10:30:00", time.Date(2024, time.September, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-09-01 10:30:00", time.Date(2024, time.September, 1, 10, 30, 0, 0, time.UTC)},
+		{"2024-09-01", time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)},
+		{"01/09/2024", time.Date(2024, time.September, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	require.Len(t, tests, len(dateLayouts), "every layout should be tested")
+
+	for _, tt := range tests {
+		rows, _, err := DecodeCSV[row](strings.NewReader("data\n" + tt.value + "\n"))
+		require.NoError(t, err, "DecodeCSV should parse %q", tt.value)
+		assert.True(t, tt.want.Equal(rows[0].Date), "wrong date for %q: %v", tt.value, rows[0].Date)
+	}
+
+	_, _, err := DecodeCSV[row](strings.NewReader("data\n1 settembre 2024\n"))
+	assert.Error(t, err, "DecodeCSV should fail on an unknown layout")
+}
+
+func TestParseItalianBool(t *testing.T) {
+	for s, want := range map[string]bool{"sì": true, "Si": true, "VERO": true, "true": true, "1": true, "no": false, "falso": false, "false": false, "0": false} {
+		got, err := ParseItalianBool(s)
+		require.NoError(t, err, "ParseItalianBool(%q) should not return an error", s)
+		assert.Equal(t, want, got, "ParseItalianBool(%q)", s)
+	}
+
+	_, err := ParseItalianBool("forse")
+	assert.Error(t, err, "ParseItalianBool should fail on an invalid value")
+}
+
+func TestParseItalianFloat(t *testing.T) {
+	for s, want := range map[string]float64{"1.234,5": 1234.5, "0,25": 0.25, "1234.5": 1234.5, "42": 42} {
+		got, err := ParseItalianFloat(s)
+		require.NoError(t, err, "ParseItalianFloat(%q) should not return an error", s)
+		assert.Equal(t, want, got, "ParseItalianFloat(%q)", s)
+	}
+}
@@ -6,27 +6,91 @@
 package opendata
 
 import (
-	"encoding/csv"
+	"context"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
-	"strconv"
 
 	"github.com/cartabinaria/unibo-go/ckan"
 	"github.com/cartabinaria/unibo-go/degree"
 )
 
+// degreeRow is a row of the degree programmes dataset.
+type degreeRow struct {
+	AcademicYear          string `csv:"anno"`
+	OpenForRegistration   string `csv:"immatricolabile"`
+	Code                  string `csv:"corso_codice"`
+	Description           string `csv:"corso_descrizione"`
+	Url                   string `csv:"url"`
+	Campus                string `csv:"campus"`
+	TeachingLocation      string `csv:"sededidattica"`
+	Fields                string `csv:"ambiti"`
+	Type                  string `csv:"tipologia"`
+	DurationInYears       int    `csv:"durata"`
+	International         bool   `csv:"internazionale"`
+	InternationalTitle    string `csv:"internazionale_titolo"`
+	InternationalLanguage string `csv:"internazionale_lingua"`
+	Languages             string `csv:"lingue"`
+	AccessRequirements    string `csv:"accesso"`
+}
+
+func (r degreeRow) toDegree() degree.Degree {
+	return degree.Degree{
+		AcademicYear:          r.AcademicYear,
+		OpenForRegistration:   r.OpenForRegistration,
+		Code:                  r.Code,
+		Description:           r.Description,
+		Url:                   r.Url,
+		Campus:                r.Campus,
+		TeachingLocation:      r.TeachingLocation,
+		Fields:                r.Fields,
+		Type:                  r.Type,
+		DurationInYears:       r.DurationInYears,
+		International:         r.International,
+		InternationalTitle:    r.InternationalTitle,
+		InternationalLanguage: r.InternationalLanguage,
+		Languages:             r.Languages,
+		AccessRequirements:    r.AccessRequirements,
+	}
+}
+
 const (
 	packageDegreeProgrammesId     = "degree-programmes" // the id of the package containing the degrees
-	resourceDegreeProgrammesAlias = "corsi_latest_it"   // the alias of the resource containing the degrees
+	resourceDegreeProgrammesAlias = "corsi_latest_"     // the alias of the resource containing the degrees, followed by the language
 )
 
 // GetDegrees fetches and returns the degrees available in the open data for the
 // current year.
 func GetDegrees() ([]degree.Degree, error) {
+	return GetDegreesContext(context.Background())
+}
+
+// GetDegreesContext is like GetDegrees, but the requests are bound to ctx.
+func GetDegreesContext(ctx context.Context) ([]degree.Degree, error) {
+	return DefaultClient.GetDegrees(ctx)
+}
+
+// GetDegrees fetches and returns the degrees available in the open data for the
+// current year, in the language of the client (see WithLanguage).
+func (c *Client) GetDegrees(ctx context.Context) ([]degree.Degree, error) {
+	resources, err := c.degreeResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get wanted resource
+	alias := resourceDegreeProgrammesAlias + string(c.getLanguage())
+	resource, found := ckan.GetByAlias(resources, alias)
+	if !found {
+		return nil, errors.New("unable to find resource '" + alias + "'")
+	}
+
+	return c.readDegrees(ctx, resource)
+}
+
+// degreeResources returns the resources of the degree programmes package.
+func (c *Client) degreeResources(ctx context.Context) ([]ckan.Resource, error) {
 	// Get package
-	pack, err := ckanClient.GetPackage(packageDegreeProgrammesId)
+	pack, err := c.ckan.GetPackageContext(ctx, packageDegreeProgrammesId)
 	if err != nil {
 		return nil, err
 	}
@@ -36,72 +100,88 @@ func GetDegrees() ([]degree.Degree, error) {
 		return nil, errors.New("no resources found while downloading degrees open data")
 	}
 
-	// Get wanted resource
-	resource, found := ckan.GetByAlias(pack.Resources, resourceDegreeProgrammesAlias)
-	if !found {
-		return nil, errors.New("unable to find resource '" + resourceDegreeProgrammesAlias + "'")
-	}
+	return pack.Resources, nil
+}
 
+// readDegrees downloads and parses a CSV resource of the degree programmes package.
+func (c *Client) readDegrees(ctx context.Context, resource *ckan.Resource) ([]degree.Degree, error) {
 	// Get the resource
-	res, err := http.Get(resource.URL)
+	body, err := c.ckan.OpenResource(ctx, *resource, ckan.DownloadOptions{ContentTypes: []string{"text/csv"}})
 	if err != nil {
-		return nil, fmt.Errorf("unable to get resource: %w", err)
-	} else if res.Header.Get("Content-Type") != "text/csv" {
-		return nil, errors.New("resource is not a csv file")
+		return nil, err
 	}
+	defer body.Close()
 
 	// Parse the body
-	reader := csv.NewReader(res.Body)
-
-	// Skip first line
-	_, err = reader.Read()
+	rows, warnings, err := DecodeCSV[degreeRow](body)
 	if err != nil {
-		return nil, fmt.Errorf("unable to skip first line: %w", err)
+		return nil, fmt.Errorf("unable to parse degrees: %w", err)
 	}
+	c.warn(resource, warnings)
 
-	degrees := make([]degree.Degree, 0, 100)
-	for {
-		row, err := reader.Read()
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, fmt.Errorf("unable to read row: %w", err)
-		}
+	degrees := make([]degree.Degree, 0, len(rows))
+	for _, row := range rows {
+		degrees = append(degrees, row.toDegree())
+	}
 
-		if len(row) != 15 {
-			return nil, fmt.Errorf("unexpected number of fields: %d (the website has changed?)", len(row))
-		}
+	return degrees, nil
+}
 
-		degreeCode := row[2]
+// GetMergedDegrees fetches the degrees available in the open data for the
+// current year both in Italian and in English, and returns the Italian ones
+// with Degree.English set to the English fields.
+func GetMergedDegrees() ([]degree.Degree, error) {
+	return GetMergedDegreesContext(context.Background())
+}
 
-		years, err := strconv.ParseInt(row[9], 10, 32)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse field 'years': %w", err)
-		}
+// GetMergedDegreesContext is like GetMergedDegrees, but the requests are bound to ctx.
+func GetMergedDegreesContext(ctx context.Context) ([]degree.Degree, error) {
+	return DefaultClient.GetMergedDegrees(ctx)
+}
 
-		international, err := strconv.ParseBool(row[10])
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse field 'international': %w", err)
-		}
+// GetMergedDegrees fetches the degrees available in the open data for the
+// current year both in Italian and in English, and returns the Italian ones
+// with Degree.English set to the English fields. The language of the client
+// is ignored.
+//
+// Degrees missing from the English resource have a nil Degree.English.
+func (c *Client) GetMergedDegrees(ctx context.Context) ([]degree.Degree, error) {
+	degrees, err := c.WithLanguage(Italian).GetDegrees(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		degrees = append(degrees, degree.Degree{
-			AcademicYear:          row[0],
-			OpenForRegistration:   row[1],
-			Code:                  degreeCode,
-			Description:           row[3],
-			Url:                   row[4],
-			Campus:                row[5],
-			TeachingLocation:      row[6],
-			Fields:                row[7],
-			Type:                  row[8],
-			DurationInYears:       int(years),
-			International:         international,
-			InternationalTitle:    row[11],
-			InternationalLanguage: row[12],
-			Languages:             row[13],
-			AccessRequirements:    row[14],
-		})
+	english, err := c.WithLanguage(English).GetDegrees(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	return degrees, nil
+	return mergeDegrees(degrees, english), nil
+}
+
+// mergeDegrees sets the English fields of the Italian degrees, matching them by code.
+func mergeDegrees(italian, english []degree.Degree) []degree.Degree {
+	byCode := make(map[string]*degree.Degree, len(english))
+	for i := range english {
+		byCode[english[i].Code] = &english[i]
+	}
+
+	for i := range italian {
+		en, found := byCode[italian[i].Code]
+		if !found {
+			continue
+		}
+		italian[i].English = &degree.Translation{
+			Description:         en.Description,
+			Url:                 en.Url,
+			Campus:              en.Campus,
+			Fields:              en.Fields,
+			Type:                en.Type,
+			OpenForRegistration: en.OpenForRegistration,
+			Languages:           en.Languages,
+			AccessRequirements:  en.AccessRequirements,
+			TeachingLocation:    en.TeachingLocation,
+		}
+	}
+	return italian
 }
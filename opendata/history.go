@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package opendata
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cartabinaria/unibo-go/ckan"
+	"github.com/cartabinaria/unibo-go/degree"
+)
+
+var (
+	// yearAliasRegex matches the alias of a yearly resource, e.g. "corsi_2024_it".
+	yearAliasRegex = regexp.MustCompile(`^corsi_(\d{4})_([a-z]{2})$`)
+	// yearNameRegex matches the name of a yearly resource, e.g. "Corsi di studio 2024/2025".
+	yearNameRegex = map[Language]*regexp.Regexp{
+		Italian: regexp.MustCompile(`(?i)^corsi di studio (\d{4})/\d{4}`),
+		English: regexp.MustCompile(`(?i)^degree programmes (\d{4})/\d{4}`),
+	}
+)
+
+// resourceYear returns the academic year of a resource of the degree
+// programmes package in the given language, i.e. the year it starts in,
+// looking at its aliases first and at its name then.
+func resourceYear(resource ckan.Resource, lang Language) (int, bool) {
+	for _, alias := range strings.Split(resource.Alias, ", ") {
+		match := yearAliasRegex.FindStringSubmatch(strings.TrimSpace(alias))
+		if match != nil && match[2] == string(lang) {
+			year, _ := strconv.Atoi(match[1])
+			return year, true
+		}
+	}
+
+	if resource.Language != "" && !strings.EqualFold(resource.Language, string(lang)) {
+		return 0, false
+	}
+	if regex, ok := yearNameRegex[lang]; ok {
+		if match := regex.FindStringSubmatch(strings.TrimSpace(resource.Name)); match != nil {
+			year, _ := strconv.Atoi(match[1])
+			return year, true
+		}
+	}
+	return 0, false
+}
+
+// yearResources returns the yearly resources of the degree programmes
+// package in the language of the client, keyed by academic year.
+func (c *Client) yearResources(ctx context.Context) (map[int]*ckan.Resource, error) {
+	resources, err := c.degreeResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byYear := make(map[int]*ckan.Resource)
+	for i := range resources {
+		year, ok := resourceYear(resources[i], c.getLanguage())
+		if !ok {
+			continue
+		}
+		// Prefer the resources with an alias over the ones matched by name
+		if _, found := byYear[year]; !found || resources[i].Alias != "" {
+			byYear[year] = &resources[i]
+		}
+	}
+	return byYear, nil
+}
+
+// GetDegreeYears returns the academic years available in the open data, in
+// ascending order. Each year is the one the academic year starts in, e.g.
+// 2024 for 2024/2025.
+func GetDegreeYears() ([]int, error) {
+	return GetDegreeYearsContext(context.Background())
+}
+
+// GetDegreeYearsContext is like GetDegreeYears, but the requests are bound to ctx.
+func GetDegreeYearsContext(ctx context.Context) ([]int, error) {
+	return DefaultClient.GetDegreeYears(ctx)
+}
+
+// GetDegreeYears returns the academic years available in the open data, in
+// ascending order.
+func (c *Client) GetDegreeYears(ctx context.Context) ([]int, error) {
+	byYear, err := c.yearResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Sorted(maps.Keys(byYear)), nil
+}
+
+// GetDegreesForYear fetches and returns the degrees of the given academic
+// year, e.g. 2024 for 2024/2025.
+func GetDegreesForYear(year int) ([]degree.Degree, error) {
+	return GetDegreesForYearContext(context.Background(), year)
+}
+
+// GetDegreesForYearContext is like GetDegreesForYear, but the requests are bound to ctx.
+func GetDegreesForYearContext(ctx context.Context, year int) ([]degree.Degree, error) {
+	return DefaultClient.GetDegreesForYear(ctx, year)
+}
+
+// GetDegreesForYear fetches and returns the degrees of the given academic
+// year, e.g. 2024 for 2024/2025, in the language of the client (see WithLanguage).
+func (c *Client) GetDegreesForYear(ctx context.Context, year int) ([]degree.Degree, error) {
+	byYear, err := c.yearResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, found := byYear[year]
+	if !found {
+		return nil, fmt.Errorf("unable to find degrees for academic year %d/%d", year, year+1)
+	}
+
+	return c.readDegrees(ctx, resource)
+}
+
+// GetDegreeHistory fetches the degree with the given code from every academic
+// year available, and returns it once per year in ascending order. The years
+// in which the degree was not offered are skipped.
+//
+// It can be used to track how a degree changed over time, e.g. its campus,
+// languages and access requirements. Degree.AcademicYear tells the year of
+// each entry.
+func GetDegreeHistory(code string) ([]degree.Degree, error) {
+	return GetDegreeHistoryContext(context.Background(), code)
+}
+
+// GetDegreeHistoryContext is like GetDegreeHistory, but the requests are bound to ctx.
+func GetDegreeHistoryContext(ctx context.Context, code string) ([]degree.Degree, error) {
+	return DefaultClient.GetDegreeHistory(ctx, code)
+}
+
+// GetDegreeHistory fetches the degree with the given code from every academic
+// year available, and returns it once per year in ascending order.
+//
+// See the package-level GetDegreeHistory for more information.
+func (c *Client) GetDegreeHistory(ctx context.Context, code string) ([]degree.Degree, error) {
+	byYear, err := c.yearResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []degree.Degree
+	for _, year := range slices.Sorted(maps.Keys(byYear)) {
+		degrees, err := c.readDegrees(ctx, byYear[year])
+		if err != nil {
+			return nil, fmt.Errorf("academic year %d/%d: %w", year, year+1, err)
+		}
+
+		i := slices.IndexFunc(degrees, func(d degree.Degree) bool { return d.Code == code })
+		if i >= 0 {
+			history = append(history, degrees[i])
+		}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package opendata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const degreesHeader = "anno,immatricolabile,corso_codice,corso_descrizione,url,campus,sededidattica,ambiti,tipologia,durata,internazionale,internazionale_titolo,internazionale_lingua,lingue,accesso\n"
+
+// serveDegrees starts a CKAN server whose degree programmes package has a
+// resource for each of the given CSV files, keyed by "alias|name".
+func serveDegrees(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var resources string
+	i := 0
+	for key, content := range files {
+		alias, name, _ := strings.Cut(key, "|")
+		path := fmt.Sprintf("/files/%d.csv", i)
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = w.Write([]byte(degreesHeader + content))
+		})
+		if i > 0 {
+			resources += ","
+		}
+		resources += fmt.Sprintf(`{"alias": %q, "name": %q, "url": %q}`, alias, name, server.URL+path)
+		i++
+	}
+
+	mux.HandleFunc("/api/3/action/package_show", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, packageDegreeProgrammesId, r.URL.Query().Get("id"), "unexpected package id")
+		_, _ = fmt.Fprintf(w, `{"success": true, "result": {"resources": [%s]}}`, resources)
+	})
+
+	return server.URL
+}
+
+func TestGetDegreesForYear(t *testing.T) {
+	url := serveDegrees(t, map[string]string{
+		"corsi_latest_it, corsi_2024_it|Corsi di studio 2024/2025": "2024/2025,SI,8009,Informatica,,Bologna,Bologna,,L,3,NO,,,italiano,libero\n",
+		"|Corsi di studio 2023/2024":                               "2023/2024,SI,8009,Informatica,,Cesena,Cesena,,L,3,NO,,,italiano,programmato\n",
+		"|Degree programmes 2023/2024":                             "",
+	})
+	client := NewClient(unibo.WithBaseURL(url))
+
+	years, err := client.GetDegreeYears(context.Background())
+	require.NoError(t, err, "GetDegreeYears should not return an error")
+	assert.Equal(t, []int{2023, 2024}, years, "years should be sorted")
+
+	degrees, err := client.GetDegreesForYear(context.Background(), 2023)
+	require.NoError(t, err, "GetDegreesForYear should not return an error")
+	require.Len(t, degrees, 1, "one degree should be returned")
+	assert.Equal(t, "Cesena", degrees[0].Campus, "the resource of 2023 should be read")
+
+	_, err = client.GetDegreesForYear(context.Background(), 2010)
+	assert.Error(t, err, "GetDegreesForYear should fail for a missing year")
+}
+
+func TestGetDegreeHistory(t *testing.T) {
+	url := serveDegrees(t, map[string]string{
+		"corsi_2022_it|": "2022/2023,SI,9254,Ingegneria,,Bologna,Bologna,,LM,2,NO,,,italiano,libero\n",
+		"corsi_2023_it|": "2023/2024,SI,8009,Informatica,,Cesena,Cesena,,L,3,NO,,,italiano,programmato\n",
+		"corsi_2024_it|": "2024/2025,SI,8009,Informatica,,Bologna,Bologna,,L,3,NO,,,italiano,libero\n",
+	})
+	client := NewClient(unibo.WithBaseURL(url))
+
+	history, err := client.GetDegreeHistory(context.Background(), "8009")
+	require.NoError(t, err, "GetDegreeHistory should not return an error")
+	require.Len(t, history, 2, "the years without the degree should be skipped")
+	assert.Equal(t, "2023/2024", history[0].AcademicYear, "history should be sorted by year")
+	assert.Equal(t, "programmato", history[0].AccessRequirements)
+	assert.Equal(t, "2024/2025", history[1].AcademicYear, "history should be sorted by year")
+	assert.Equal(t, "libero", history[1].AccessRequirements)
+}
+
+func TestGetDegreesForYearEnglish(t *testing.T) {
+	url := serveDegrees(t, map[string]string{
+		"corsi_2024_it|":               "2024/2025,SI,8009,Informatica,,Bologna,Bologna,,L,3,NO,,,italiano,libero\n",
+		"|Degree programmes 2024/2025": "2024/2025,YES,8009,Computer Science,,Bologna,Bologna,,First cycle,3,NO,,,Italian,open\n",
+	})
+	client := NewClient(unibo.WithBaseURL(url)).WithLanguage(English)
+
+	degrees, err := client.GetDegreesForYear(context.Background(), 2024)
+	require.NoError(t, err, "GetDegreesForYear should not return an error")
+	require.Len(t, degrees, 1, "one degree should be returned")
+	assert.Equal(t, "Computer Science", degrees[0].Description, "the English resource should be read")
+}
+
+func TestGetMergedDegrees(t *testing.T) {
+	url := serveDegrees(t, map[string]string{
+		"corsi_latest_it|": "2024/2025,SI,8009,Informatica,,Bologna,Bologna,,L,3,NO,,,italiano,libero\n" +
+			"2024/2025,SI,9254,Ingegneria,,Bologna,Bologna,,LM,2,NO,,,italiano,libero\n",
+		"corsi_latest_en|": "2024/2025,YES,8009,Computer Science,,Bologna,Bologna,,First cycle,3,NO,,,Italian,open\n",
+	})
+
+	degrees, err := NewClient(unibo.WithBaseURL(url)).GetMergedDegrees(context.Background())
+	require.NoError(t, err, "GetMergedDegrees should not return an error")
+	require.Len(t, degrees, 2, "the Italian degrees should be returned")
+
+	assert.Equal(t, "Informatica", degrees[0].Description, "the Italian fields should be kept")
+	require.NotNil(t, degrees[0].English, "the English fields should be set")
+	assert.Equal(t, "Computer Science", degrees[0].English.Description)
+	assert.Equal(t, "Computer Science", degrees[0].EnglishDescription())
+
+	assert.Nil(t, degrees[1].English, "degrees missing in English should have no translation")
+	assert.Equal(t, "Ingegneria", degrees[1].EnglishDescription(), "the Italian description should be the fallback")
+}
@@ -8,8 +8,63 @@
 // Internally it uses the ckan package to interact with the CKAN API that dati.unibo.it offers.
 package opendata
 
-import "github.com/cartabinaria/unibo-go/ckan"
+import (
+	"github.com/cartabinaria/unibo-go"
+	"github.com/cartabinaria/unibo-go/ckan"
+)
 
 const openDataUrl = "https://dati.unibo.it"
 
 var ckanClient = ckan.NewClient(openDataUrl)
+
+// Client fetches data from the UniBo Open Data portal.
+type Client struct {
+	// Warn, if not nil, is called with the problems found while decoding a
+	// resource, e.g. a column that was added or removed from the dataset.
+	Warn func(resource ckan.Resource, warnings []Warning)
+
+	ckan     *ckan.Client // The client used to query the CKAN API and download resources
+	language Language     // The language of the resources to read. If empty, Italian is used.
+}
+
+// Language is the language of a resource of the open data portal.
+type Language string
+
+const (
+	Italian Language = "it"
+	English Language = "en"
+)
+
+// WithLanguage returns a copy of the client that reads the resources in the
+// given language, e.g. the English degree programmes.
+//
+//	degrees, err := opendata.DefaultClient.WithLanguage(opendata.English).GetDegrees(ctx)
+func (c *Client) WithLanguage(lang Language) *Client {
+	clone := *c
+	clone.language = lang
+	return &clone
+}
+
+func (c *Client) getLanguage() Language {
+	if c.language == "" {
+		return Italian
+	}
+	return c.language
+}
+
+// NewClient creates a new open data Client configured with the given options.
+//
+// unibo.WithBaseURL replaces "https://dati.unibo.it".
+func NewClient(opts ...unibo.Option) *Client {
+	baseURL := unibo.NewClient(opts...).BaseURL(openDataUrl)
+	return &Client{ckan: ckan.NewClient(baseURL, opts...)}
+}
+
+// DefaultClient is the Client used by the package-level functions.
+var DefaultClient = &Client{ckan: ckanClient}
+
+func (c *Client) warn(resource *ckan.Resource, warnings []Warning) {
+	if c.Warn != nil && len(warnings) > 0 {
+		c.Warn(*resource, warnings)
+	}
+}
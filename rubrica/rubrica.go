@@ -6,11 +6,13 @@
 package rubrica
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"strings"
 
 	"github.com/antchfx/htmlquery"
+
+	"github.com/cartabinaria/unibo-go"
 )
 
 type Contact struct {
@@ -24,12 +26,51 @@ type Contact struct {
 
 // These are declared as variables to allow for easier testing and mocking
 var (
-	baseUrl    = "https://www.unibo.it/uniboweb/unibosearch/rubrica.aspx?tab=PersonePanel&mode=people&query="
-	httpClient = &http.Client{}
+	baseUrl = "https://www.unibo.it/uniboweb/unibosearch/rubrica.aspx?tab=PersonePanel&mode=people&query="
 )
 
+const rubricaPath = "/uniboweb/unibosearch/rubrica.aspx?tab=PersonePanel&mode=people&query="
+
+// Client searches the university's directory.
+//
+// The zero value uses unibo.DefaultClient.
+type Client struct {
+	client *unibo.Client
+}
+
+// NewClient creates a new rubrica Client configured with the given options.
+//
+// unibo.WithBaseURL replaces "https://www.unibo.it".
+func NewClient(opts ...unibo.Option) *Client {
+	return &Client{client: unibo.NewClient(opts...)}
+}
+
+// DefaultClient is the Client used by the package-level functions.
+var DefaultClient = &Client{}
+
+func (c *Client) http() *unibo.Client {
+	if c == nil || c.client == nil {
+		return unibo.DefaultClient
+	}
+	return c.client
+}
+
 func Search(firstName, lastName string) ([]Contact, error) {
+	return SearchContext(context.Background(), firstName, lastName)
+}
+
+// SearchContext is like Search, but the request is bound to ctx.
+func SearchContext(ctx context.Context, firstName, lastName string) ([]Contact, error) {
+	return DefaultClient.Search(ctx, firstName, lastName)
+}
+
+// Search returns the contacts matching the given first and last name.
+// Either of them can be empty.
+func (c *Client) Search(ctx context.Context, firstName, lastName string) ([]Contact, error) {
 	url := baseUrl
+	if base := c.http().BaseURL(""); base != "" {
+		url = base + rubricaPath
+	}
 	if firstName != "" {
 		url += "+nome:" + firstName
 	}
@@ -37,10 +78,11 @@ func Search(firstName, lastName string) ([]Contact, error) {
 		url += "+cognome:" + lastName
 	}
 
-	res, err := http.Get(url)
+	res, err := c.http().Get(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get res: %w", err)
 	}
+	defer res.Body.Close()
 
 	// parse res
 	node, err := htmlquery.Parse(res.Body)
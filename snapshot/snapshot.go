@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+// Package snapshot stores the last seen version of some data, e.g. a
+// timetable, to find what changed since the last run.
+//
+//	store := snapshot.NewStore[timetable.Timetable](dir)
+//	old, found, err := store.Load("laurea-informatica-1")
+//	// ...
+//	changes := timetable.Diff(old, current)
+//	err = store.Save("laurea-informatica-1", current)
+package snapshot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Store is a set of snapshots of type T, each stored as a JSON file in a
+// directory. It is safe for concurrent use with different keys.
+type Store[T any] struct {
+	dir string
+}
+
+// NewStore returns a Store saving the snapshots in dir, which is created if
+// missing.
+func NewStore[T any](dir string) *Store[T] {
+	return &Store[T]{dir: dir}
+}
+
+// DefaultDir returns the default directory of the snapshots of the given
+// kind (e.g. "timetable"), in the user cache directory.
+func DefaultDir(kind string) (string, error) {
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to find cache directory: %w", err)
+	}
+	return filepath.Join(cache, "unibo", "snapshots", kind), nil
+}
+
+// path returns the file of the snapshot with the given key, replacing the
+// characters not allowed in file names.
+func (s *Store[T]) path(key string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+	return filepath.Join(s.dir, name+".json")
+}
+
+// Load returns the snapshot with the given key, and whether it was found.
+func (s *Store[T]) Load(key string) (T, bool, error) {
+	var value T
+
+	buf, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return value, false, nil
+	} else if err != nil {
+		return value, false, fmt.Errorf("unable to read snapshot: %w", err)
+	}
+
+	err = json.Unmarshal(buf, &value)
+	if err != nil {
+		return value, false, fmt.Errorf("unable to parse snapshot: %w", err)
+	}
+	return value, true, nil
+}
+
+// Save stores value as the snapshot with the given key, replacing the
+// previous one atomically.
+func (s *Store[T]) Save(key string, value T) error {
+	buf, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to encode snapshot: %w", err)
+	}
+
+	err = os.MkdirAll(s.dir, 0o755)
+	if err != nil {
+		return fmt.Errorf("unable to write snapshot: %w", err)
+	}
+
+	path := s.path(key)
+	tmp, err := os.CreateTemp(s.dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("unable to write snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(buf)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("unable to write snapshot: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("unable to write snapshot: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package snapshot
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "snapshots")
+	store := NewStore[[]string](dir)
+
+	_, found, err := store.Load("laurea/informatica/1")
+	require.NoError(t, err)
+	assert.False(t, found, "a missing snapshot should not be found")
+
+	require.NoError(t, store.Save("laurea/informatica/1", []string{"a", "b"}))
+	require.NoError(t, store.Save("laurea/informatica/1", []string{"c"}))
+
+	value, found, err := store.Load("laurea/informatica/1")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, []string{"c"}, value, "the last snapshot should be returned")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "temporary files should be removed")
+	assert.Equal(t, "laurea_informatica_1.json", entries[0].Name())
+}
+
+func TestStoreInvalid(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0o644))
+
+	_, _, err := NewStore[[]string](dir).Load("broken")
+	assert.Error(t, err)
+}
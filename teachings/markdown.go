@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package teachings
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// markdown converts the given nodes to markdown text. Only the elements found
+// in the syllabus pages are converted (paragraphs, lists, links, emphasis,
+// headings and line breaks): the others are replaced by their text.
+func markdown(nodes []*html.Node) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		writeMarkdown(&b, n, "")
+	}
+	return tidyMarkdown(b.String())
+}
+
+func writeMarkdown(b *strings.Builder, n *html.Node, indent string) {
+	switch n.Type {
+	case html.TextNode:
+		b.WriteString(duplicatedSpaceRemover.ReplaceAllString(n.Data, " "))
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	children := func() {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			writeMarkdown(b, child, indent)
+		}
+	}
+
+	switch n.Data {
+	case "script", "style":
+	case "p", "div":
+		b.WriteString("\n\n")
+		children()
+		b.WriteString("\n\n")
+	case "br":
+		b.WriteString("\n" + indent)
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level, _ := strconv.Atoi(n.Data[1:])
+		b.WriteString("\n\n" + strings.Repeat("#", max(level, 3)) + " ")
+		children()
+		b.WriteString("\n\n")
+	case "strong", "b":
+		b.WriteString("**")
+		children()
+		b.WriteString("**")
+	case "em", "i":
+		b.WriteString("*")
+		children()
+		b.WriteString("*")
+	case "a":
+		href := attr(n, "href")
+		if href == "" {
+			children()
+			return
+		}
+		b.WriteString("[")
+		children()
+		b.WriteString("](" + href + ")")
+	case "ul", "ol":
+		b.WriteString("\n")
+		i := 0
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode || child.Data != "li" {
+				continue
+			}
+			i++
+			bullet := "- "
+			if n.Data == "ol" {
+				bullet = strconv.Itoa(i) + ". "
+			}
+			b.WriteString("\n" + indent + bullet)
+			for grandchild := child.FirstChild; grandchild != nil; grandchild = grandchild.NextSibling {
+				writeMarkdown(b, grandchild, indent+"  ")
+			}
+		}
+		b.WriteString("\n\n")
+	default:
+		children()
+	}
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// tidyMarkdown trims the spaces around each line and collapses the blank lines.
+func tidyMarkdown(s string) string {
+	lines := strings.Split(s, "\n")
+	out := make([]string, 0, len(lines))
+	blank := true // Drop the leading blank lines
+	for _, line := range lines {
+		// Keep the indentation of nested lists
+		trimmed := strings.TrimRight(line, " \t")
+		indent := len(trimmed) - len(strings.TrimLeft(trimmed, " "))
+		content := strings.Join(strings.Fields(trimmed), " ")
+		if content == "" {
+			if !blank {
+				out = append(out, "")
+			}
+			blank = true
+			continue
+		}
+		if !strings.HasPrefix(content, "- ") && !isOrderedItem(content) {
+			indent = 0
+		}
+		out = append(out, strings.Repeat(" ", indent)+content)
+		blank = false
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+func isOrderedItem(s string) bool {
+	dot := strings.Index(s, ". ")
+	if dot <= 0 {
+		return false
+	}
+	_, err := strconv.Atoi(s[:dot])
+	return err == nil
+}
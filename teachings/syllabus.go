@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package teachings
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+)
+
+const (
+	syllabusBaseUrl = "https://www.unibo.it"
+	syllabusPathIt  = "/it/studiare/insegnamenti-competenze-trasversali-moocs/insegnamenti/insegnamento/%d/%d"
+	syllabusPathEn  = "/en/study/course-units-transferable-skills-moocs/course-unit-catalogue/course-unit/%d/%d"
+)
+
+// syllabusUrlRegex matches the academic year and the page id at the end of
+// the url of a syllabus page, in any language.
+var syllabusUrlRegex = regexp.MustCompile(`/(\d{4})/(\d+)/?(?:[?#].*)?$`)
+
+// titleCodeRegex matches the code of the teaching at the start of the title
+// of a syllabus page, e.g. "72677 - ANALISI DELLE RETI SOCIALI".
+var titleCodeRegex = regexp.MustCompile(`^(\d+)\s*-`)
+
+// Languages of the syllabus pages, used by FetchSyllabus.
+const (
+	Italian = "it"
+	English = "en"
+)
+
+// Section is a section of a syllabus, e.g. "Course contents".
+type Section struct {
+	Title    string // The title of the section, as shown on the page
+	Markdown string // The content of the section, converted to markdown
+}
+
+// Syllabus is the description of a teaching, as published on its page.
+//
+// The known sections are also available by name, e.g. Contents. They are
+// empty if the page does not have them.
+type Syllabus struct {
+	Code         string    // The code of the teaching, read from the title or, if missing, from the teachings. Can be empty.
+	PageID       int       // The id of the page, e.g. 400239. It is not the code of the teaching.
+	AcademicYear int       // The academic year, i.e. the year it starts in, e.g. 2024 for 2024/2025
+	Language     string    // The language of the page, Italian or English
+	Url          string    // The url of the page
+	Title        string    // The title of the page, usually the name of the teaching
+	Sections     []Section // All the sections, in the order of the page
+
+	LearningOutcomes  string   // What the students will learn
+	Contents          string   // The topics of the teaching
+	Readings          string   // The books and materials
+	TeachingMethods   string   // How the teaching is given
+	AssessmentMethods string   // How the exam works
+	TeachingTools     string   // The tools supporting the teaching
+	OfficeHours       string   // The office hours of the teachers
+	VirtualeLinks     []string // The links to the teaching on virtuale.unibo.it
+}
+
+// sectionTitles maps the titles of the known sections, lowercase and in
+// both languages, to the field of Syllabus they fill.
+var sectionTitles = map[string]func(s *Syllabus) *string{
+	"conoscenze e abilità da conseguire": func(s *Syllabus) *string { return &s.LearningOutcomes },
+	"learning outcomes":                  func(s *Syllabus) *string { return &s.LearningOutcomes },
+	"contenuti":                          func(s *Syllabus) *string { return &s.Contents },
+	"course contents":                    func(s *Syllabus) *string { return &s.Contents },
+	"testi/bibliografia":                 func(s *Syllabus) *string { return &s.Readings },
+	"readings/bibliography":              func(s *Syllabus) *string { return &s.Readings },
+	"metodi didattici":                   func(s *Syllabus) *string { return &s.TeachingMethods },
+	"teaching methods":                   func(s *Syllabus) *string { return &s.TeachingMethods },
+	"modalità di verifica e valutazione dell'apprendimento": func(s *Syllabus) *string { return &s.AssessmentMethods },
+	"assessment methods":                   func(s *Syllabus) *string { return &s.AssessmentMethods },
+	"strumenti a supporto della didattica": func(s *Syllabus) *string { return &s.TeachingTools },
+	"teaching tools":                       func(s *Syllabus) *string { return &s.TeachingTools },
+	"orario di ricevimento":                func(s *Syllabus) *string { return &s.OfficeHours },
+	"office hours":                         func(s *Syllabus) *string { return &s.OfficeHours },
+}
+
+// GetSyllabusUrl returns the URL of the syllabus page with the given id, for
+// the given academic year and language.
+//
+// The page id is not the code of the teaching: it is the last element of
+// Teaching.Url, see ParseSyllabusUrl.
+func (c *Client) GetSyllabusUrl(pageID, academicYear int, lang string) string {
+	path := syllabusPathIt
+	if lang == English {
+		path = syllabusPathEn
+	}
+	return fmt.Sprintf(c.http().BaseURL(syllabusBaseUrl)+path, academicYear, pageID)
+}
+
+// ParseSyllabusUrl returns the academic year and the page id of the url of a
+// syllabus page, e.g. Teaching.Url, in any language.
+//
+//	year, id, err := teachings.ParseSyllabusUrl(teaching.Url)
+//	// ...
+//	syllabus, err := teachings.FetchSyllabus(id, year, teachings.English)
+func ParseSyllabusUrl(url string) (academicYear, pageID int, err error) {
+	match := syllabusUrlRegex.FindStringSubmatch(url)
+	if match == nil {
+		return 0, 0, fmt.Errorf("invalid syllabus url %q: expected the academic year and the page id", url)
+	}
+	academicYear, _ = strconv.Atoi(match[1])
+	pageID, err = strconv.Atoi(match[2])
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid syllabus url %q: %w", url, err)
+	}
+	return academicYear, pageID, nil
+}
+
+// FetchSyllabus returns the syllabus page with the given id (see
+// ParseSyllabusUrl) for the given academic year (e.g. 2024 for 2024/2025), in
+// the given language (Italian or English).
+//
+// To fetch the syllabus of a teaching code, e.g. timetable.Event.CodModulo,
+// use FetchSyllabusByCode.
+func FetchSyllabus(pageID, academicYear int, lang string) (*Syllabus, error) {
+	return FetchSyllabusContext(context.Background(), pageID, academicYear, lang)
+}
+
+// FetchSyllabusContext is like FetchSyllabus, but the request is bound to ctx.
+func FetchSyllabusContext(ctx context.Context, pageID, academicYear int, lang string) (*Syllabus, error) {
+	return DefaultClient.FetchSyllabus(ctx, pageID, academicYear, lang)
+}
+
+// FetchSyllabus returns the syllabus page with the given id for the given
+// academic year, in the given language (Italian or English).
+//
+// unibo.WithBaseURL replaces "https://www.unibo.it" for the syllabus pages.
+func (c *Client) FetchSyllabus(ctx context.Context, pageID, academicYear int, lang string) (*Syllabus, error) {
+	if lang != Italian && lang != English {
+		return nil, fmt.Errorf("unsupported language %q: expected %q or %q", lang, Italian, English)
+	}
+
+	return c.fetchSyllabus(ctx, c.GetSyllabusUrl(pageID, academicYear, lang), pageID, academicYear, lang)
+}
+
+// FindTeaching returns the teaching with the given code, e.g.
+// timetable.Event.CodModulo or exams.Exam.SubjectCode. Module codes also
+// match their teaching, e.g. "28004_1" matches the teaching "28004".
+func FindTeaching(teachings []Teaching, code string) (Teaching, bool) {
+	code = strings.TrimSpace(code)
+	for _, t := range teachings {
+		if t.Code == code || strings.HasPrefix(code, t.Code+"_") {
+			return t, true
+		}
+	}
+	return Teaching{}, false
+}
+
+// FetchSyllabusByCode returns the syllabus of the teaching with the given
+// code (see FindTeaching), in the given language (Italian or English). The
+// teaching is looked up in the teachings of the given course, year and
+// curriculum (see FetchTeachings), and its syllabus is the one linked by
+// Teaching.Url, for the academic year of the teachings page.
+func FetchSyllabusByCode(courseType, courseId string, year int, curriculum, code, lang string) (*Syllabus, error) {
+	return FetchSyllabusByCodeContext(context.Background(), courseType, courseId, year, curriculum, code, lang)
+}
+
+// FetchSyllabusByCodeContext is like FetchSyllabusByCode, but the requests
+// are bound to ctx.
+func FetchSyllabusByCodeContext(ctx context.Context, courseType, courseId string, year int, curriculum, code, lang string) (*Syllabus, error) {
+	return DefaultClient.FetchSyllabusByCode(ctx, courseType, courseId, year, curriculum, code, lang)
+}
+
+// FetchSyllabusByCode returns the syllabus of the teaching with the given
+// code, found in the teachings of the given course, year and curriculum, in
+// the given language (Italian or English).
+func (c *Client) FetchSyllabusByCode(ctx context.Context, courseType, courseId string, year int, curriculum, code, lang string) (*Syllabus, error) {
+	if lang != Italian && lang != English {
+		return nil, fmt.Errorf("unsupported language %q: expected %q or %q", lang, Italian, English)
+	}
+
+	teachings, err := c.FetchTeachings(ctx, courseType, courseId, year, curriculum)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := FindTeaching(teachings, code)
+	if !ok {
+		return nil, fmt.Errorf("unable to find the syllabus of teaching %s: not in the teachings of %s/%s", code, courseType, courseId)
+	}
+	if t.Url == "" {
+		return nil, fmt.Errorf("unable to find the syllabus of teaching %s: the teaching has no page", code)
+	}
+
+	academicYear, pageID, err := ParseSyllabusUrl(t.Url)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the syllabus of teaching %s: %w", code, err)
+	}
+
+	syllabus, err := c.FetchSyllabus(ctx, pageID, academicYear, lang)
+	if err != nil {
+		return nil, err
+	}
+	if syllabus.Code == "" {
+		syllabus.Code = t.Code
+	}
+	return syllabus, nil
+}
+
+// FetchSyllabusURL returns the syllabus at the given url, e.g. Teaching.Url.
+func FetchSyllabusURL(url string) (*Syllabus, error) {
+	return FetchSyllabusURLContext(context.Background(), url)
+}
+
+// FetchSyllabusURLContext is like FetchSyllabusURL, but the request is bound to ctx.
+func FetchSyllabusURLContext(ctx context.Context, url string) (*Syllabus, error) {
+	return DefaultClient.FetchSyllabusURL(ctx, url)
+}
+
+// FetchSyllabusURL returns the syllabus at the given url, e.g. Teaching.Url.
+// The academic year, the page id and the language are read from the url.
+func (c *Client) FetchSyllabusURL(ctx context.Context, url string) (*Syllabus, error) {
+	academicYear, pageID, err := ParseSyllabusUrl(url)
+	if err != nil {
+		return nil, err
+	}
+
+	lang := Italian
+	if strings.Contains(url, "/en/") {
+		lang = English
+	}
+
+	return c.fetchSyllabus(ctx, url, pageID, academicYear, lang)
+}
+
+func (c *Client) fetchSyllabus(ctx context.Context, url string, pageID, academicYear int, lang string) (*Syllabus, error) {
+	res, err := c.http().Get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch syllabus from url %s: %w", url, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch syllabus from url %s: unexpected status code %d", url, res.StatusCode)
+	}
+
+	syllabus, err := parseSyllabusHtml(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse syllabus from url %s: %w", url, err)
+	}
+
+	if match := titleCodeRegex.FindStringSubmatch(syllabus.Title); match != nil {
+		syllabus.Code = match[1]
+	}
+	syllabus.PageID = pageID
+	syllabus.AcademicYear = academicYear
+	syllabus.Language = lang
+	syllabus.Url = url
+	return syllabus, nil
+}
+
+// parseSyllabusHtml parses a syllabus page, whose sections are introduced by
+// h2 headings inside the main content.
+func parseSyllabusHtml(r io.Reader) (*Syllabus, error) {
+	node, err := htmlquery.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load page: %w", err)
+	}
+
+	content := htmlquery.FindOne(node, "//div[@id='u-content-main']")
+	if content == nil {
+		content = htmlquery.FindOne(node, "//main")
+	}
+	if content == nil {
+		return nil, fmt.Errorf("unable to find the content while parsing syllabus. maybe the html structure has changed")
+	}
+
+	s := &Syllabus{}
+	if title := htmlquery.FindOne(content, ".//h1"); title != nil {
+		s.Title = cleanText(htmlquery.InnerText(title))
+	}
+
+	// Each section is made of the nodes following its h2, up to the next one
+	for _, heading := range htmlquery.Find(content, ".//h2") {
+		var nodes []*html.Node
+		for n := heading.NextSibling; n != nil && n.Data != "h2"; n = n.NextSibling {
+			nodes = append(nodes, n)
+		}
+
+		section := Section{Title: cleanText(htmlquery.InnerText(heading)), Markdown: markdown(nodes)}
+		s.Sections = append(s.Sections, section)
+		if field, ok := sectionTitles[strings.ToLower(section.Title)]; ok {
+			*field(s) = section.Markdown
+		}
+	}
+
+	if len(s.Sections) == 0 {
+		return nil, fmt.Errorf("unable to find any section while parsing syllabus. maybe the html structure has changed")
+	}
+
+	for _, link := range htmlquery.Find(content, ".//a[contains(@href, 'virtuale.unibo.it')]") {
+		if href := htmlquery.SelectAttr(link, "href"); href != "" {
+			s.VirtualeLinks = append(s.VirtualeLinks, href)
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package teachings
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const syllabusPage = `
+<html><body>
+<div id="u-content-main">
+    <h1>72677 - ANALISI DELLE RETI SOCIALI</h1>
+    <h2>Learning outcomes</h2>
+    <p>At the end of the course, the student <strong>knows</strong> the
+       main models of social networks.</p>
+    <h2>Course contents</h2>
+    <ul>
+        <li>Graphs and <em>centrality</em></li>
+        <li>Communities
+            <ul><li>Modularity</li></ul>
+        </li>
+    </ul>
+    <h2>Readings/Bibliography</h2>
+    <ol><li>Easley, Kleinberg. <a href="https://www.cs.cornell.edu/home/kleinber/networks-book/">Networks, Crowds, and Markets</a></li></ol>
+    <h2>Teaching tools</h2>
+    <p>Slides on <a href="https://virtuale.unibo.it/course/view.php?id=123">Virtuale</a>.</p>
+    <h2>Office hours</h2>
+    <p>See the website of <a href="https://www.unibo.it/sitoweb/saverio.giallorenzo">Saverio Giallorenzo</a></p>
+    <h2>SDGs</h2>
+    <p>Quality education</p>
+</div>
+</body></html>`
+
+func TestFetchSyllabus(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/en/study/course-units-transferable-skills-moocs/course-unit-catalogue/course-unit/2024/400239", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(syllabusPage))
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	s, err := NewClient(unibo.WithBaseURL(server.URL)).FetchSyllabus(context.Background(), 400239, 2024, English)
+	require.NoError(t, err, "FetchSyllabus should not return an error")
+
+	assert.Equal(t, "72677", s.Code, "the code should be read from the title")
+	assert.Equal(t, 400239, s.PageID)
+	assert.Equal(t, 2024, s.AcademicYear)
+	assert.Equal(t, "72677 - ANALISI DELLE RETI SOCIALI", s.Title)
+	assert.Len(t, s.Sections, 6, "unknown sections should be kept")
+	assert.Equal(t, "SDGs", s.Sections[5].Title)
+
+	assert.Equal(t, "At the end of the course, the student **knows** the main models of social networks.", s.LearningOutcomes)
+	assert.Equal(t, "- Graphs and *centrality*\n- Communities\n\n  - Modularity", s.Contents)
+	assert.Equal(t, "1. Easley, Kleinberg. [Networks, Crowds, and Markets](https://www.cs.cornell.edu/home/kleinber/networks-book/)", s.Readings)
+	assert.Equal(t, "See the website of [Saverio Giallorenzo](https://www.unibo.it/sitoweb/saverio.giallorenzo)", s.OfficeHours)
+	assert.Empty(t, s.AssessmentMethods, "missing sections should be empty")
+	assert.Equal(t, []string{"https://virtuale.unibo.it/course/view.php?id=123"}, s.VirtualeLinks)
+}
+
+func TestFetchSyllabusByCode(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/laurea/Informatica/insegnamenti", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2", r.URL.Query().Get("anno"), "unexpected year")
+		_, _ = w.Write([]byte(teachingsPage))
+	})
+	handler.HandleFunc("/en/study/course-units-transferable-skills-moocs/course-unit-catalogue/course-unit/2024/400239", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(syllabusPage))
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	client := NewClient(unibo.WithBaseURL(server.URL))
+	for _, code := range []string{"72677", "72677_1"} {
+		s, err := client.FetchSyllabusByCode(context.Background(), "laurea", "Informatica", 2, "", code, English)
+		require.NoError(t, err, "FetchSyllabusByCode should not return an error for %s", code)
+		assert.Equal(t, "72677", s.Code)
+		assert.Equal(t, 400239, s.PageID, "the page id should be read from the url of the teaching")
+		assert.Equal(t, 2024, s.AcademicYear)
+		assert.Equal(t, English, s.Language)
+	}
+
+	_, err := client.FetchSyllabusByCode(context.Background(), "laurea", "Informatica", 2, "", "99999", English)
+	assert.Error(t, err, "FetchSyllabusByCode should fail for a code not in the teachings")
+}
+
+func TestFindTeaching(t *testing.T) {
+	teachings := []Teaching{{Code: "00819"}, {Code: "28004"}}
+
+	teaching, ok := FindTeaching(teachings, "28004_1")
+	require.True(t, ok, "a module code should match its teaching")
+	assert.Equal(t, "28004", teaching.Code)
+
+	_, ok = FindTeaching(teachings, "2800")
+	assert.False(t, ok, "a prefix of a code should not match")
+}
+
+func TestFetchSyllabusURL(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/it/studiare/insegnamenti-competenze-trasversali-moocs/insegnamenti/insegnamento/2024/400239", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(syllabusPage))
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	url := server.URL + "/it/studiare/insegnamenti-competenze-trasversali-moocs/insegnamenti/insegnamento/2024/400239"
+	s, err := NewClient().FetchSyllabusURL(context.Background(), url)
+	require.NoError(t, err, "FetchSyllabusURL should not return an error")
+
+	assert.Equal(t, "72677", s.Code)
+	assert.Equal(t, 400239, s.PageID, "the page id should be read from the url")
+	assert.Equal(t, 2024, s.AcademicYear, "the academic year should be read from the url")
+	assert.Equal(t, Italian, s.Language)
+	assert.Equal(t, url, s.Url)
+}
+
+func TestParseSyllabusUrl(t *testing.T) {
+	year, id, err := ParseSyllabusUrl("https://www.unibo.it/it/studiare/insegnamenti-competenze-trasversali-moocs/insegnamenti/insegnamento/2024/320579")
+	require.NoError(t, err)
+	assert.Equal(t, 2024, year)
+	assert.Equal(t, 320579, id, "the page id of 00819 should be returned, not its code")
+
+	_, _, err = ParseSyllabusUrl("https://corsi.unibo.it/laurea/Informatica")
+	assert.Error(t, err, "a url without the page id should be rejected")
+}
+
+func TestFetchSyllabusLanguage(t *testing.T) {
+	_, err := FetchSyllabus(400239, 2024, "fr")
+	assert.Error(t, err, "FetchSyllabus should reject unsupported languages")
+}
@@ -0,0 +1,348 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+/*
+Package teachings provides methods to retrieve the teachings (insegnamenti)
+of a degree.
+
+Every degree has a webpage listing its teachings, grouped by year and by
+mandatory or elective activities. For example, the teachings of the degree
+"Ingegneria Informatica" are available at:
+
+	https://corsi.unibo.it/laurea/IngegneriaInformatica/insegnamenti
+
+This package parses that page.
+*/
+package teachings
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/antchfx/htmlquery"
+	"golang.org/x/net/html"
+
+	"github.com/cartabinaria/unibo-go"
+)
+
+var baseUrl = "https://corsi.unibo.it"
+
+const (
+	teachingsPathIt = "/%s/%s/insegnamenti"
+	teachingsPathEn = "/%s/%s/course-structure-diagram"
+)
+
+// Teaching represents a teaching (insegnamento) of a degree.
+type Teaching struct {
+	Code       string   // The code of the teaching, e.g. "00819"
+	Name       string   // The name of the teaching, e.g. "PROGRAMMAZIONE"
+	CFU        int      // The credits of the teaching
+	SSD        string   // The scientific sector of the teaching, e.g. "INF/01"
+	Year       int      // The year of the degree in which the teaching is taught, e.g. 1
+	Period     string   // The period in which the teaching is taught, e.g. "1° ciclo"
+	Language   string   // The language in which the teaching is taught, e.g. "Italiano"
+	Teachers   []string // The names of the teachers
+	Curriculum string   // The curriculum the teaching belongs to, as requested
+	Mandatory  bool     // Whether the teaching is mandatory. If false, it is elective.
+	Url        string   // The url of the teaching page, with its syllabus (see FetchSyllabusURL). Can be empty.
+}
+
+// Client fetches teachings from the University website.
+//
+// The zero value uses unibo.DefaultClient.
+type Client struct {
+	client *unibo.Client
+}
+
+// NewClient creates a new teachings Client configured with the given options.
+//
+// unibo.WithBaseURL replaces "https://corsi.unibo.it".
+func NewClient(opts ...unibo.Option) *Client {
+	return &Client{client: unibo.NewClient(opts...)}
+}
+
+// DefaultClient is the Client used by the package-level functions.
+var DefaultClient = &Client{}
+
+func (c *Client) http() *unibo.Client {
+	if c == nil || c.client == nil {
+		return unibo.DefaultClient
+	}
+	return c.client
+}
+
+// GetTeachingsUrl returns the URL of the teachings page of the given course,
+// for the given year and curriculum. If curriculum is empty, the default one
+// is used.
+func (c *Client) GetTeachingsUrl(courseType, courseId string, year int, curriculum string) string {
+	path := teachingsPathIt
+	if strings.Contains(courseType, "cycle") {
+		path = teachingsPathEn
+	}
+
+	v := url.Values{}
+	v.Set("anno", strconv.Itoa(year))
+	v.Set("curricula", curriculum)
+	return fmt.Sprintf(c.http().BaseURL(baseUrl)+path, courseType, courseId) + "?" + v.Encode()
+}
+
+// FetchTeachings returns the teachings of the given course for the given year
+// and curriculum (see curriculum.Curriculum.Value). If curriculum is empty,
+// the default one is used.
+func FetchTeachings(courseType, courseId string, year int, curriculum string) ([]Teaching, error) {
+	return FetchTeachingsContext(context.Background(), courseType, courseId, year, curriculum)
+}
+
+// FetchTeachingsContext is like FetchTeachings, but the request is bound to ctx.
+func FetchTeachingsContext(ctx context.Context, courseType, courseId string, year int, curriculum string) ([]Teaching, error) {
+	return DefaultClient.FetchTeachings(ctx, courseType, courseId, year, curriculum)
+}
+
+// FetchTeachings returns the teachings of the given course for the given year
+// and curriculum. If curriculum is empty, the default one is used.
+func (c *Client) FetchTeachings(ctx context.Context, courseType, courseId string, year int, curriculum string) ([]Teaching, error) {
+	url := c.GetTeachingsUrl(courseType, courseId, year, curriculum)
+
+	res, err := c.http().Get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch teachings from url %s: %w", url, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch teachings from url %s: unexpected status code %d", url, res.StatusCode)
+	}
+
+	teachings, err := parseTeachingsHtml(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse teachings from url %s: %w", url, err)
+	}
+
+	for i := range teachings {
+		teachings[i].Curriculum = curriculum
+		if teachings[i].Year == 0 {
+			teachings[i].Year = year
+		}
+	}
+
+	return teachings, nil
+}
+
+var (
+	duplicatedSpaceRemover = regexp.MustCompile(`\s+`)
+	leadingNumberRegex     = regexp.MustCompile(`^\d+`)
+	yearRegex              = regexp.MustCompile(`(?i)(\d+)\s*(?:°|º|st|nd|rd|th)?\s*(?:anno|year)`)
+	teachersSeparator      = regexp.MustCompile(`[,;]`)
+)
+
+func cleanText(text string) string {
+	return strings.TrimSpace(duplicatedSpaceRemover.ReplaceAllString(text, " "))
+}
+
+// column is a column of the tables of the teachings page.
+type column int
+
+const (
+	columnCode column = iota
+	columnName
+	columnCFU
+	columnSSD
+	columnPeriod
+	columnLanguage
+	columnTeachers
+)
+
+// columnNames are the lowercase headers of each column, in Italian and
+// English. The most specific columns come first, since a header is matched by
+// prefix to the first column with a matching name, e.g. "course unit code" is
+// the code and "teaching period" the period, not the name.
+var columnNames = []struct {
+	col   column
+	names []string
+}{
+	{columnCode, []string{"codice", "code", "course unit code", "course code", "teaching code"}},
+	{columnTeachers, []string{"docent", "teacher", "professor"}},
+	{columnCFU, []string{"cfu", "crediti", "credits"}},
+	{columnSSD, []string{"ssd", "settore", "sector", "scientific sector"}},
+	{columnPeriod, []string{"periodo", "ciclo", "period", "semestre", "semester", "teaching period"}},
+	{columnLanguage, []string{"lingua", "language", "teaching language"}},
+	{columnName, []string{"insegnamento", "attività", "course unit", "teaching"}},
+}
+
+// parseTeachingsHtml parses the teachings page. The page lists the teachings
+// in tables, preceded by headings with the year (e.g. "1° anno") and the kind
+// of activities (e.g. "Attività obbligatorie" or "Attività a scelta").
+//
+// The columns of the tables are found by their header, e.g. "Codice" or
+// "CFU", so that their order does not matter.
+func parseTeachingsHtml(r io.Reader) ([]Teaching, error) {
+	node, err := htmlquery.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load page: %w", err)
+	}
+
+	teachings := make([]Teaching, 0)
+	year, mandatory := 0, true
+	found := false
+
+	// The headings and tables are visited in document order
+	var parseErr error
+	walk(node, func(n *html.Node) bool {
+		switch n.Data {
+		case "h2", "h3", "h4", "h5":
+			heading := cleanText(htmlquery.InnerText(n))
+			if match := yearRegex.FindStringSubmatch(heading); match != nil {
+				year, _ = strconv.Atoi(match[1])
+			} else {
+				mandatory = isMandatory(heading)
+			}
+		case "table":
+			columns := parseHeader(n)
+			if _, ok := columns[columnCode]; !ok {
+				return true // Not a table of teachings
+			}
+			found = true
+
+			for _, row := range htmlquery.Find(n, ".//tr[td]") {
+				teaching, err := parseTeachingRow(row, columns)
+				if err != nil {
+					parseErr = err
+					return false
+				}
+				teaching.Year = year
+				teaching.Mandatory = mandatory
+				teachings = append(teachings, teaching)
+			}
+		}
+		return true
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if !found {
+		return nil, fmt.Errorf("unable to find the teachings while parsing teachings. maybe the html structure has changed")
+	}
+
+	return teachings, nil
+}
+
+// parseHeader returns the index of each known column of the table, by the
+// text of its header cells. A header equal to a name of a column is preferred
+// to one that only starts with it.
+func parseHeader(table *html.Node) map[column]int {
+	columns := make(map[column]int)
+	for i, th := range htmlquery.Find(table, ".//tr[th][1]/th") {
+		header := strings.ToLower(cleanText(htmlquery.InnerText(th)))
+		for _, match := range []func(name string) bool{
+			func(name string) bool { return header == name },
+			func(name string) bool { return strings.HasPrefix(header, name) },
+		} {
+			if col, ok := findColumn(columns, match); ok {
+				columns[col] = i
+				break
+			}
+		}
+	}
+	return columns
+}
+
+// findColumn returns the first column not yet in columns with a name
+// satisfying match.
+func findColumn(columns map[column]int, match func(name string) bool) (column, bool) {
+	for _, c := range columnNames {
+		if _, ok := columns[c.col]; ok {
+			continue
+		}
+		if slices.ContainsFunc(c.names, match) {
+			return c.col, true
+		}
+	}
+	return 0, false
+}
+
+// walk calls fn for each element below n in document order, until fn returns false.
+func walk(n *html.Node, fn func(*html.Node) bool) bool {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && !fn(child) {
+			return false
+		}
+		if !walk(child, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// isMandatory reports whether a heading introduces mandatory activities.
+func isMandatory(heading string) bool {
+	heading = strings.ToLower(heading)
+	return !strings.Contains(heading, "scelta") && !strings.Contains(heading, "elective") &&
+		!strings.Contains(heading, "opzional") && !strings.Contains(heading, "optional")
+}
+
+func parseTeachingRow(row *html.Node, columns map[column]int) (Teaching, error) {
+	cells := htmlquery.Find(row, "./td")
+	cellNode := func(col column) *html.Node {
+		i, ok := columns[col]
+		if !ok || i >= len(cells) {
+			return nil
+		}
+		return cells[i]
+	}
+	cell := func(col column) string {
+		if n := cellNode(col); n != nil {
+			return cleanText(htmlquery.InnerText(n))
+		}
+		return ""
+	}
+
+	t := Teaching{
+		Code:     cell(columnCode),
+		Name:     cell(columnName),
+		SSD:      cell(columnSSD),
+		Period:   cell(columnPeriod),
+		Language: cell(columnLanguage),
+	}
+	if t.Code == "" {
+		return Teaching{}, fmt.Errorf("unable to find code while parsing teachings. maybe the html structure has changed")
+	}
+
+	if n := cellNode(columnName); n != nil {
+		if link := htmlquery.FindOne(n, ".//a"); link != nil {
+			t.Name = cleanText(htmlquery.InnerText(link))
+			t.Url = htmlquery.SelectAttr(link, "href")
+		}
+	}
+	if t.Name == "" {
+		return Teaching{}, fmt.Errorf("unable to find name of teaching %s while parsing teachings. maybe the html structure has changed", t.Code)
+	}
+
+	// The teachers are separated by commas or by elements, e.g. <br> or <li>
+	if n := cellNode(columnTeachers); n != nil {
+		for _, text := range htmlquery.Find(n, ".//text()") {
+			for _, teacher := range teachersSeparator.Split(text.Data, -1) {
+				if name := cleanText(teacher); name != "" {
+					t.Teachers = append(t.Teachers, name)
+				}
+			}
+		}
+	}
+
+	if cfu := cell(columnCFU); cfu != "" {
+		n, err := strconv.Atoi(leadingNumberRegex.FindString(cfu))
+		if err != nil {
+			return Teaching{}, fmt.Errorf("unable to parse the credits %q of teaching %s: %w", cfu, t.Code, err)
+		}
+		t.CFU = n
+	}
+
+	return t, nil
+}
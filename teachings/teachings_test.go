@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package teachings
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cartabinaria/unibo-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// teachingsPage has the structure of the teachings page: a heading for each
+// year and kind of activities, followed by a table whose columns are named by
+// its header row.
+const teachingsPage = `
+<html><body>
+<div id="content-core">
+    <h2>1° anno</h2>
+    <h3>Attività obbligatorie</h3>
+    <table class="table">
+        <thead>
+            <tr><th>Codice</th><th>Insegnamento</th><th>Docenti</th><th>CFU</th><th>SSD</th><th>Periodo</th><th>Lingua</th></tr>
+        </thead>
+        <tbody>
+            <tr>
+                <td>00819</td>
+                <td><a href="https://www.unibo.it/it/studiare/insegnamenti-competenze-trasversali-moocs/insegnamenti/insegnamento/2024/320579">PROGRAMMAZIONE</a></td>
+                <td>Mario Rossi<br>Anna Bianchi</td>
+                <td>12</td>
+                <td>INF/01</td>
+                <td>1° ciclo</td>
+                <td>Italiano</td>
+            </tr>
+        </tbody>
+    </table>
+    <h2>2° anno</h2>
+    <h3>Attività a scelta</h3>
+    <table class="table">
+        <thead>
+            <tr><th>Codice</th><th>CFU</th><th>Insegnamento</th><th>Docenti</th><th>SSD</th><th>Lingua</th><th>Periodo</th></tr>
+        </thead>
+        <tbody>
+            <tr>
+                <td>72677</td>
+                <td>6 CFU</td>
+                <td><a href="https://www.unibo.it/it/studiare/insegnamenti-competenze-trasversali-moocs/insegnamenti/insegnamento/2024/400239">ANALISI DELLE RETI
+                    SOCIALI</a></td>
+                <td>Saverio Giallorenzo</td>
+                <td>INF/01</td>
+                <td>Inglese</td>
+                <td>2° ciclo</td>
+            </tr>
+        </tbody>
+    </table>
+</div>
+</body></html>`
+
+func TestFetchTeachings(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/laurea/Informatica/insegnamenti", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("anno"), "unexpected year")
+		assert.Equal(t, "000-000", r.URL.Query().Get("curricula"), "unexpected curriculum")
+		_, _ = w.Write([]byte(teachingsPage))
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	teachings, err := NewClient(unibo.WithBaseURL(server.URL)).FetchTeachings(context.Background(), "laurea", "Informatica", 1, "000-000")
+	require.NoError(t, err, "FetchTeachings should not return an error")
+	require.Len(t, teachings, 2, "every row should be parsed")
+
+	assert.Equal(t, Teaching{
+		Code:       "00819",
+		Name:       "PROGRAMMAZIONE",
+		CFU:        12,
+		SSD:        "INF/01",
+		Year:       1,
+		Period:     "1° ciclo",
+		Language:   "Italiano",
+		Teachers:   []string{"Mario Rossi", "Anna Bianchi"},
+		Curriculum: "000-000",
+		Mandatory:  true,
+		Url:        "https://www.unibo.it/it/studiare/insegnamenti-competenze-trasversali-moocs/insegnamenti/insegnamento/2024/320579",
+	}, teachings[0])
+
+	assert.Equal(t, "ANALISI DELLE RETI SOCIALI", teachings[1].Name, "spaces should be collapsed")
+	assert.Equal(t, 6, teachings[1].CFU)
+	assert.Equal(t, 2, teachings[1].Year, "the year should be read from the heading")
+	assert.False(t, teachings[1].Mandatory, "teachings under \"a scelta\" should be elective")
+	assert.Equal(t, "Inglese", teachings[1].Language, "the columns should be found by their header")
+}
+
+// teachingsPageEn has the structure of the English teachings page, whose
+// headers share words such as "course unit" and "teaching".
+const teachingsPageEn = `
+<html><body>
+<div id="content-core">
+    <h2>1st year</h2>
+    <h3>Elective activities</h3>
+    <table class="table">
+        <thead>
+            <tr><th>Course unit code</th><th>Course unit</th><th>Teachers</th><th>Credits</th><th>Scientific sector</th><th>Teaching period</th><th>Teaching language</th></tr>
+        </thead>
+        <tbody>
+            <tr>
+                <td>91252</td>
+                <td><a href="https://www.unibo.it/en/study/course-units-transferable-skills-moocs/course-unit-catalogue/course-unit/2024/446610">ADVANCED OPERATING SYSTEMS</a></td>
+                <td>Renzo Davoli</td>
+                <td>6</td>
+                <td>INF/01</td>
+                <td>2nd cycle</td>
+                <td>English</td>
+            </tr>
+        </tbody>
+    </table>
+</div>
+</body></html>`
+
+func TestFetchTeachingsEnglish(t *testing.T) {
+	handler := http.NewServeMux()
+	handler.HandleFunc("/2cycle/ComputerScience/course-structure-diagram", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(teachingsPageEn))
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	teachings, err := NewClient(unibo.WithBaseURL(server.URL)).FetchTeachings(context.Background(), "2cycle", "ComputerScience", 1, "")
+	require.NoError(t, err, "FetchTeachings should not return an error")
+	require.Len(t, teachings, 1, "every row should be parsed")
+
+	assert.Equal(t, Teaching{
+		Code:      "91252",
+		Name:      "ADVANCED OPERATING SYSTEMS",
+		CFU:       6,
+		SSD:       "INF/01",
+		Year:      1,
+		Period:    "2nd cycle",
+		Language:  "English",
+		Teachers:  []string{"Renzo Davoli"},
+		Mandatory: false,
+		Url:       "https://www.unibo.it/en/study/course-units-transferable-skills-moocs/course-unit-catalogue/course-unit/2024/446610",
+	}, teachings[0], "the columns should be found by their English header")
+}
+
+func TestFetchTeachingsChangedPage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`<html><body><p>Pagina non trovata</p></body></html>`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(unibo.WithBaseURL(server.URL)).FetchTeachings(context.Background(), "laurea", "Informatica", 1, "")
+	assert.Error(t, err, "FetchTeachings should fail if the page has changed")
+}
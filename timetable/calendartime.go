@@ -6,6 +6,7 @@ package timetable
 
 import (
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -23,9 +24,15 @@ const (
 	ianaTimezone = "Europe/Rome"           // The IANA timezone of the dates in the timetable
 )
 
-var cachedTimezone *time.Location
+var (
+	cachedTimezone   *time.Location
+	cachedTimezoneMu sync.Mutex // Guards the loading of cachedTimezone, since timetables can be decoded concurrently
+)
 
 func cacheTimezone() (err error) {
+	cachedTimezoneMu.Lock()
+	defer cachedTimezoneMu.Unlock()
+
 	if cachedTimezone != nil {
 		return
 	}
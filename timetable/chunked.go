@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const (
+	// DefaultWindowDays is the number of days fetched by each request of
+	// FetchTimetableChunked, if not set.
+	DefaultWindowDays = 7
+	// DefaultConcurrency is the maximum number of concurrent requests made by
+	// FetchTimetableChunked, if not set.
+	DefaultConcurrency = 4
+)
+
+// ChunkOptions configures FetchTimetableChunked.
+type ChunkOptions struct {
+	WindowDays  int // The number of days fetched by each request. If not positive, DefaultWindowDays is used.
+	Concurrency int // The maximum number of concurrent requests. If not positive, DefaultConcurrency is used.
+
+	// Progress, if not nil, is called after each window is fetched, with the
+	// number of windows done (failed ones included) and the total. The calls
+	// are never concurrent.
+	Progress func(done, total int)
+}
+
+// WindowError is the error of fetching a single window of a timetable.
+type WindowError struct {
+	Interval Interval
+	Err      error
+}
+
+func (e *WindowError) Error() string {
+	return fmt.Sprintf("%s - %s: %v", e.Interval.Start.Format("2006-01-02"), e.Interval.End.Format("2006-01-02"), e.Err)
+}
+
+func (e *WindowError) Unwrap() error {
+	return e.Err
+}
+
+// windows splits the days of the interval, both included, in intervals of
+// the given number of days. The last one can be shorter.
+func (i Interval) windows(days int) []Interval {
+	var windows []Interval
+	end := at(i.End, 0)
+	for start := at(i.Start, 0); !start.After(end); start = start.AddDate(0, 0, days) {
+		windowEnd := start.AddDate(0, 0, days-1)
+		if windowEnd.After(end) {
+			windowEnd = end
+		}
+		windows = append(windows, Interval{Start: start, End: windowEnd})
+	}
+	return windows
+}
+
+// FetchTimetableChunked retrieves the timetable for the given course in the
+// given interval, with a request for each window of opts.WindowDays days.
+// Use it for long intervals, e.g. a whole semester, for which a single
+// request is slow and can be truncated.
+//
+// See FetchTimetable for the meaning of the other parameters.
+func FetchTimetableChunked(
+	courseType, courseId, curriculum string,
+	year int,
+	interval Interval,
+	opts ChunkOptions,
+) (Timetable, error) {
+	return FetchTimetableChunkedContext(context.Background(), courseType, courseId, curriculum, year, interval, opts)
+}
+
+// FetchTimetableChunkedContext is like FetchTimetableChunked, but the requests are bound to ctx.
+func FetchTimetableChunkedContext(
+	ctx context.Context,
+	courseType, courseId, curriculum string,
+	year int,
+	interval Interval,
+	opts ChunkOptions,
+) (Timetable, error) {
+	return DefaultClient.FetchTimetableChunked(ctx, courseType, courseId, curriculum, year, interval, opts)
+}
+
+// FetchTimetableChunked retrieves the timetable for the given course in the
+// given interval, with a request for each window of opts.WindowDays days,
+// making at most opts.Concurrency requests at a time. The events of the
+// windows are merged, sorted by start time and deduplicated.
+//
+// A failed window does not stop the others: the returned timetable holds the
+// events of the windows fetched successfully, and the error joins a
+// *WindowError for each failed window, in the order of the windows.
+func (c *Client) FetchTimetableChunked(
+	ctx context.Context,
+	courseType, courseId, curriculum string,
+	year int,
+	interval Interval,
+	opts ChunkOptions,
+) (Timetable, error) {
+	days := opts.WindowDays
+	if days <= 0 {
+		days = DefaultWindowDays
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	windows := interval.windows(days)
+	limit := make(chan struct{}, concurrency)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		chunks = make([]Timetable, len(windows))
+		errs   = make([]error, len(windows)) // By window, so that they are joined in order
+		done   int
+	)
+
+	for i, window := range windows {
+		wg.Go(func() {
+			t, err := c.fetchWindow(ctx, limit, courseType, courseId, curriculum, year, window)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[i] = &WindowError{Interval: window, Err: err}
+			} else {
+				chunks[i] = t
+			}
+			done++
+			if opts.Progress != nil {
+				opts.Progress(done, len(windows))
+			}
+		})
+	}
+	wg.Wait()
+
+	return Dedupe(Merge(chunks...)), errors.Join(errs...)
+}
+
+func (c *Client) fetchWindow(
+	ctx context.Context,
+	limit chan struct{},
+	courseType, courseId, curriculum string,
+	year int,
+	window Interval,
+) (Timetable, error) {
+	select {
+	case limit <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-limit }()
+
+	return c.FetchTimetable(ctx, courseType, courseId, curriculum, year, &window)
+}
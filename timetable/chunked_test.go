@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cartabinaria/unibo-go"
+)
+
+func TestFetchTimetableChunked(t *testing.T) {
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		start, err := time.Parse("2006-01-02", r.URL.Query().Get("start"))
+		if err != nil {
+			t.Error("wrong start", r.URL.RawQuery)
+		}
+		end, err := time.Parse("2006-01-02", r.URL.Query().Get("end"))
+		if err != nil {
+			t.Error("wrong end", r.URL.RawQuery)
+		}
+		if start.Format("2006-01-02") == "2024-10-21" {
+			http.Error(w, "timeout", http.StatusGatewayTimeout)
+			return
+		}
+
+		// A lesson each day, and the lesson of the day before the window,
+		// which must be deduplicated
+		var events []string
+		for day := start.AddDate(0, 0, -1); !day.After(end); day = day.AddDate(0, 0, 1) {
+			events = append(events, fmt.Sprintf(`{"cod_modulo": "A", "start": "%sT09:00:00", "end": "%sT11:00:00"}`,
+				day.Format("2006-01-02"), day.Format("2006-01-02")))
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(events, ",") + "]"))
+	}))
+	defer server.Close()
+
+	var calls []int
+	client := NewClient(unibo.WithBaseURL(server.URL))
+	interval := Interval{Start: rome(t, "2024-10-01 00:00"), End: rome(t, "2024-10-31 00:00")}
+	tt, err := client.FetchTimetableChunked(t.Context(), "laurea", "informatica", "", 1, interval, ChunkOptions{
+		WindowDays:  5,
+		Concurrency: 2,
+		Progress: func(done, total int) {
+			if total != 7 {
+				t.Error("wrong total", total)
+			}
+			calls = append(calls, done)
+		},
+	})
+
+	var windowErr *WindowError
+	if !errors.As(err, &windowErr) {
+		t.Fatal("expected a window error", err)
+	}
+	if got := windowErr.Interval.Start.Format("2006-01-02"); got != "2024-10-21" {
+		t.Error("wrong failed window", got)
+	}
+
+	// 31 days and the 30th of September, returned with the first window,
+	// without the failed window from the 21st to the 25th, except for the 25th
+	// returned with the next window
+	if len(tt) != 28 {
+		t.Fatal("wrong number of events", len(tt))
+	}
+	for i := 1; i < len(tt); i++ {
+		if !tt[i].Start.After(tt[i-1].Start.Time) {
+			t.Fatal("events not sorted or duplicated", tt[i-1].Start, tt[i].Start)
+		}
+	}
+
+	if len(calls) != 7 || calls[6] != 7 {
+		t.Error("wrong progress calls", calls)
+	}
+	if peak.Load() > 2 {
+		t.Error("too many concurrent requests", peak.Load())
+	}
+}
+
+func TestFetchTimetableChunkedErrorOrder(t *testing.T) {
+	// Every window fails, the later ones sooner
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start, _ := time.Parse("2006-01-02", r.URL.Query().Get("start"))
+		time.Sleep(time.Duration(31-start.Day()) * time.Millisecond)
+		http.Error(w, "timeout", http.StatusGatewayTimeout)
+	}))
+	defer server.Close()
+
+	client := NewClient(unibo.WithBaseURL(server.URL))
+	interval := Interval{Start: rome(t, "2024-10-01 00:00"), End: rome(t, "2024-10-31 00:00")}
+	_, err := client.FetchTimetableChunked(t.Context(), "laurea", "informatica", "", 1, interval, ChunkOptions{
+		WindowDays:  5,
+		Concurrency: 7,
+	})
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatal("expected joined errors", err)
+	}
+	var starts []string
+	for _, err := range joined.Unwrap() {
+		var windowErr *WindowError
+		if !errors.As(err, &windowErr) {
+			t.Fatal("expected a window error", err)
+		}
+		starts = append(starts, windowErr.Interval.Start.Format("02"))
+	}
+	if got := strings.Join(starts, " "); got != "01 06 11 16 21 26 31" {
+		t.Error("the errors should be in the order of the windows", got)
+	}
+}
+
+func TestIntervalWindows(t *testing.T) {
+	interval := Interval{Start: rome(t, "2024-10-01 15:00"), End: rome(t, "2024-10-10 08:00")}
+	windows := interval.windows(7)
+	checkIntervals(t, windows,
+		"2024-10-01 00:00", "2024-10-07 00:00",
+		"2024-10-08 00:00", "2024-10-10 00:00",
+	)
+
+	single := Interval{Start: rome(t, "2024-10-01 00:00"), End: rome(t, "2024-10-01 00:00")}
+	if len(single.windows(7)) != 1 {
+		t.Error("a single day should be a single window")
+	}
+}
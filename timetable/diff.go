@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"slices"
+)
+
+// Field is a field of an event that can change between two versions of a
+// timetable.
+type Field string
+
+const (
+	FieldTime    Field = "time"    // The start or the end
+	FieldRoom    Field = "room"    // The classrooms
+	FieldTeacher Field = "teacher" // The teacher
+	FieldTeams   Field = "teams"   // The remote learning or the Teams link
+)
+
+// Modification is an event changed between two versions of a timetable.
+type Modification struct {
+	Old, New Event
+	Fields   []Field // The fields changed, in the order of the Field constants
+}
+
+// Changes are the differences between two versions of a timetable.
+type Changes struct {
+	Added    Timetable      // The events only in the new version
+	Removed  Timetable      // The events only in the old version, e.g. cancelled lessons
+	Modified []Modification // The events in both versions, with different fields
+}
+
+// IsEmpty reports whether there are no changes.
+func (c Changes) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Modified) == 0
+}
+
+// Diff returns the changes from the old to the new version of a timetable,
+// sorted by start time.
+//
+// Events are matched by Key first, i.e. by code and start time. The events
+// left are matched by code and day, in order of start time, so a lesson
+// moved to another time of the same day is a modification, while a lesson
+// moved to another day is removed and added.
+func Diff(old, new Timetable) Changes {
+	old, new = Dedupe(Merge(old)), Dedupe(Merge(new))
+
+	var changes Changes
+	var oldLeft, newLeft Timetable
+
+	newByKey := make(map[string]Event, len(new))
+	for _, e := range new {
+		newByKey[e.Key()] = e
+	}
+	for _, o := range old {
+		n, ok := newByKey[o.Key()]
+		if !ok {
+			oldLeft = append(oldLeft, o)
+			continue
+		}
+		delete(newByKey, o.Key())
+		changes.modify(o, n)
+	}
+	for _, n := range new {
+		if _, ok := newByKey[n.Key()]; ok {
+			newLeft = append(newLeft, n)
+		}
+	}
+
+	// Match the events left by code and day, in order of start time
+	newByDay := make(map[string]Timetable)
+	for _, n := range newLeft {
+		newByDay[dayKey(n)] = append(newByDay[dayKey(n)], n)
+	}
+	matched := make(map[string]bool)
+	for _, o := range oldLeft {
+		key := dayKey(o)
+		if candidates := newByDay[key]; len(candidates) > 0 {
+			changes.modify(o, candidates[0])
+			matched[candidates[0].Key()] = true
+			newByDay[key] = candidates[1:]
+			continue
+		}
+		changes.Removed = append(changes.Removed, o)
+	}
+	for _, n := range newLeft {
+		if !matched[n.Key()] {
+			changes.Added = append(changes.Added, n)
+		}
+	}
+
+	slices.SortStableFunc(changes.Modified, func(a, b Modification) int {
+		return a.New.Start.Compare(b.New.Start.Time)
+	})
+	return changes
+}
+
+// modify adds a modification from o to n, if any field changed.
+func (c *Changes) modify(o, n Event) {
+	var fields []Field
+	if !o.Start.Equal(n.Start.Time) || !o.End.Equal(n.End.Time) {
+		fields = append(fields, FieldTime)
+	}
+	if !slices.Equal(roomKeys(o), roomKeys(n)) {
+		fields = append(fields, FieldRoom)
+	}
+	if o.Teacher != n.Teacher {
+		fields = append(fields, FieldTeacher)
+	}
+	if o.RemoteLearning != n.RemoteLearning || o.Teams != n.Teams {
+		fields = append(fields, FieldTeams)
+	}
+	if len(fields) > 0 {
+		c.Modified = append(c.Modified, Modification{Old: o, New: n, Fields: fields})
+	}
+}
+
+// dayKey identifies the event by its code and its day, regardless of the time.
+func dayKey(e Event) string {
+	return e.code() + "-" + e.Start.In(location()).Format("20060102")
+}
+
+// roomKeys returns the sorted identifiers of the classrooms of the event.
+func roomKeys(e Event) []string {
+	keys := make([]string, 0, len(e.Classrooms))
+	for _, c := range e.Classrooms {
+		keys = append(keys, newRoom(c).Code)
+	}
+	slices.Sort(keys)
+	return keys
+}
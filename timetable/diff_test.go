@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	same := lesson(t, "A", "2024-10-07 09:00", "2024-10-07 11:00")
+	moved := lesson(t, "B", "2024-10-07 14:00", "2024-10-07 16:00")
+	newRoom := inRoom(lesson(t, "C", "2024-10-08 09:00", "2024-10-08 11:00"), "331_1", "AULA 1", 100)
+	cancelled := lesson(t, "D", "2024-10-09 09:00", "2024-10-09 11:00")
+	otherDay := lesson(t, "E", "2024-10-09 14:00", "2024-10-09 16:00")
+
+	old := Timetable{same, moved, inRoom(newRoom, "331_2", "AULA 2", 100), cancelled, otherDay}
+
+	movedLater := moved
+	movedLater.Start, movedLater.End = CalendarTime{rome(t, "2024-10-07 16:00")}, CalendarTime{rome(t, "2024-10-07 18:00")}
+	movedLater.Teacher = "Nuovo Docente"
+	otherDayMoved := lesson(t, "E", "2024-10-10 14:00", "2024-10-10 16:00")
+	added := lesson(t, "F", "2024-10-10 09:00", "2024-10-10 11:00")
+
+	changes := Diff(old, Timetable{added, same, movedLater, newRoom, otherDayMoved, same})
+
+	if len(changes.Modified) != 2 {
+		t.Fatal("wrong number of modifications", changes.Modified)
+	}
+	if m := changes.Modified[0]; m.Old.CodModulo != "B" || !slices.Equal(m.Fields, []Field{FieldTime, FieldTeacher}) {
+		t.Error("wrong modification of the moved lesson", m.Old.CodModulo, m.Fields)
+	}
+	if m := changes.Modified[1]; m.Old.CodModulo != "C" || !slices.Equal(m.Fields, []Field{FieldRoom}) {
+		t.Error("wrong modification of the lesson in a new room", m.Old.CodModulo, m.Fields)
+	}
+
+	if len(changes.Removed) != 2 || changes.Removed[0].CodModulo != "D" || changes.Removed[1].CodModulo != "E" {
+		t.Error("wrong removed lessons", changes.Removed)
+	}
+	if len(changes.Added) != 2 || changes.Added[0].CodModulo != "F" || changes.Added[1].CodModulo != "E" {
+		t.Error("wrong added lessons", changes.Added)
+	}
+}
+
+func TestDiffEmpty(t *testing.T) {
+	tt := Timetable{lesson(t, "A", "2024-10-07 09:00", "2024-10-07 11:00")}
+	if changes := Diff(tt, tt); !changes.IsEmpty() {
+		t.Error("a timetable should not differ from itself", changes)
+	}
+	if changes := Diff(nil, tt); len(changes.Added) != 1 {
+		t.Error("all the lessons should be added to an empty timetable", changes)
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cartabinaria/unibo-go/ical"
+)
+
+// uidDomain is the right-hand side of the UIDs of the exported events.
+const uidDomain = "corsi.unibo.it"
+
+// Calendar converts the timetable to an iCalendar, with one event per lesson.
+//
+// The UID of each event is derived from the module, the split code and the
+// start time of the lesson, so exporting the same timetable twice yields the
+// same UIDs and calendar applications update the events instead of
+// duplicating them.
+func (t Timetable) Calendar() *ical.Calendar {
+	events := make([]ical.Event, 0, len(t))
+	for _, e := range t {
+		events = append(events, e.calendarEvent())
+	}
+	return &ical.Calendar{Events: events}
+}
+
+// WriteICS writes the timetable to w in the iCalendar format.
+//
+// See Calendar for more information.
+func (t Timetable) WriteICS(w io.Writer) error {
+	return t.Calendar().Encode(w)
+}
+
+func (e Event) calendarEvent() ical.Event {
+	var locations []string
+	for _, c := range e.Classrooms {
+		location := c.ResourceDesc
+		if c.AddressDesc != "" {
+			location += " - " + c.AddressDesc
+		}
+		locations = append(locations, location)
+	}
+
+	var description []string
+	if e.Teacher != "" {
+		description = append(description, "Docente: "+e.Teacher)
+	}
+	if e.Cfu != 0 {
+		description = append(description, fmt.Sprintf("CFU: %d", e.Cfu))
+	}
+	if e.Teams != "" {
+		description = append(description, "Teams: "+e.Teams)
+	}
+
+	return ical.Event{
+		UID:         e.uid(),
+		Start:       e.Start.Time,
+		End:         e.End.Time,
+		Summary:     e.Title,
+		Location:    strings.Join(locations, "; "),
+		Description: strings.Join(description, "\n"),
+		URL:         e.Teams,
+	}
+}
+
+// uid returns a stable identifier of the event, suitable for the UID property.
+func (e Event) uid() string {
+	return e.Key() + "@" + uidDomain
+}
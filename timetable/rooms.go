@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Room is a classroom in which lessons are held.
+type Room struct {
+	Code     string   // The code of the classroom, or its building and name if the code is unknown
+	Name     string   // The name of the classroom (e.g. "AULA 6.2")
+	Floor    string   // The floor of the classroom
+	Address  string   // The address of the building
+	Seats    int      // The number of seats
+	Building Building // The building of the classroom
+}
+
+func newRoom(c Classroom) Room {
+	r := Room{
+		Code:     c.Raw.Code,
+		Name:     c.ResourceDesc,
+		Floor:    c.FloorDesc,
+		Address:  c.AddressDesc,
+		Seats:    c.Raw.Seats,
+		Building: c.Raw.Building,
+	}
+	if r.Code == "" {
+		r.Code = c.BuildingDesc + "/" + c.ResourceDesc
+	}
+	return r
+}
+
+// InBuilding reports whether the room is in the given building, matched
+// case-insensitively against the code of the building, or contained in its
+// description, complex (plesso) or address.
+func (r Room) InBuilding(building string) bool {
+	building = strings.ToLower(strings.TrimSpace(building))
+	if building == "" {
+		return true
+	}
+	if strings.EqualFold(r.Building.Code, building) {
+		return true
+	}
+	for _, s := range []string{r.Building.Description, r.Building.Plesso, r.Address} {
+		if strings.Contains(strings.ToLower(s), building) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoomQuery selects the rooms returned by RoomIndex.Free.
+type RoomQuery struct {
+	Building string    // The building of the rooms, as in Room.InBuilding. If empty, all the rooms match.
+	From, To time.Time // The interval in which the rooms must be free
+	MinSeats int       // The minimum number of seats
+}
+
+type indexedRoom struct {
+	Room
+	events Timetable
+	keys   map[string]bool
+}
+
+// RoomIndex is an index of the classrooms used by a set of timetables, with
+// the lessons held in each of them.
+//
+// The index only knows the rooms and the lessons of the timetables added to
+// it: a room is free if none of those timetables uses it, so the timetables
+// of all the degrees sharing a building should be added to get reliable
+// answers. A RoomIndex is not safe for concurrent use.
+//
+//	index := timetable.NewRoomIndex(first, second)
+//	rooms := index.Free(timetable.RoomQuery{Building: "risorgimento", From: from, To: to, MinSeats: 50})
+type RoomIndex struct {
+	rooms map[string]*indexedRoom
+}
+
+// NewRoomIndex returns an index of the rooms of the timetables.
+func NewRoomIndex(timetables ...Timetable) *RoomIndex {
+	x := &RoomIndex{rooms: make(map[string]*indexedRoom)}
+	for _, t := range timetables {
+		x.Add(t)
+	}
+	return x
+}
+
+// Add adds the rooms and the lessons of the timetable to the index. Lessons
+// already added, e.g. lessons shared by two degrees, are ignored.
+func (x *RoomIndex) Add(t Timetable) {
+	for _, e := range t {
+		key := e.Key()
+		for _, c := range e.Classrooms {
+			room := newRoom(c)
+			r, ok := x.rooms[room.Code]
+			if !ok {
+				r = &indexedRoom{Room: room, keys: make(map[string]bool)}
+				x.rooms[room.Code] = r
+			}
+			if !r.keys[key] {
+				r.keys[key] = true
+				r.events = append(r.events, e)
+			}
+		}
+	}
+}
+
+// Len returns the number of rooms in the index.
+func (x *RoomIndex) Len() int {
+	return len(x.rooms)
+}
+
+// Rooms returns all the rooms of the index, sorted by building and name.
+func (x *RoomIndex) Rooms() []Room {
+	rooms := make([]Room, 0, len(x.rooms))
+	for _, r := range x.rooms {
+		rooms = append(rooms, r.Room)
+	}
+	sortRooms(rooms)
+	return rooms
+}
+
+// Room returns the room with the given code, and whether it was found.
+func (x *RoomIndex) Room(code string) (Room, bool) {
+	r, ok := x.rooms[code]
+	if !ok {
+		return Room{}, false
+	}
+	return r.Room, true
+}
+
+// Occupancy returns the lessons held in the room with the given code, sorted
+// by start time.
+func (x *RoomIndex) Occupancy(code string) Timetable {
+	r, ok := x.rooms[code]
+	if !ok {
+		return nil
+	}
+	return Merge(r.events)
+}
+
+// IsFree reports whether no lesson is held in the room with the given code
+// between from and to. Lessons that only touch the interval do not count.
+func (x *RoomIndex) IsFree(code string, from, to time.Time) bool {
+	r, ok := x.rooms[code]
+	if !ok {
+		return true
+	}
+	return !slices.ContainsFunc(r.events, func(e Event) bool {
+		return e.Start.Before(to) && e.End.After(from)
+	})
+}
+
+// Free returns the rooms matching the query with no lesson between q.From
+// and q.To, sorted by building and name.
+//
+// Only the rooms seen in the timetables added to the index are returned: a
+// room with no lessons in them is unknown, even if it is free. Add the
+// timetables of a wider period than [q.From, q.To], e.g. the whole week, to
+// know the rooms that are unused in the interval.
+func (x *RoomIndex) Free(q RoomQuery) []Room {
+	var rooms []Room
+	for code, r := range x.rooms {
+		if r.Seats >= q.MinSeats && r.InBuilding(q.Building) && x.IsFree(code, q.From, q.To) {
+			rooms = append(rooms, r.Room)
+		}
+	}
+	sortRooms(rooms)
+	return rooms
+}
+
+func sortRooms(rooms []Room) {
+	slices.SortFunc(rooms, func(a, b Room) int {
+		return cmp.Or(
+			cmp.Compare(a.Building.Description, b.Building.Description),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Code, b.Code),
+		)
+	})
+}
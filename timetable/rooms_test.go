@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"testing"
+)
+
+func inRoom(e Event, code, name string, seats int) Event {
+	e.Classrooms = append(e.Classrooms, Classroom{
+		ResourceDesc: name,
+		Raw: RawClassroom{
+			Code:     code,
+			Seats:    seats,
+			Building: Building{Code: "331", Description: "Facoltà di Ingegneria", Plesso: "RISORGIMENTO"},
+		},
+	})
+	return e
+}
+
+func TestRoomIndexFree(t *testing.T) {
+	first := Timetable{
+		inRoom(lesson(t, "A", "2024-10-07 09:00", "2024-10-07 11:00"), "331_1", "AULA 1", 100),
+		inRoom(lesson(t, "B", "2024-10-07 14:00", "2024-10-07 16:00"), "331_2", "AULA 2", 200),
+	}
+	second := Timetable{
+		inRoom(lesson(t, "C", "2024-10-07 16:00", "2024-10-07 18:00"), "331_3", "AULA 3", 30),
+		inRoom(lesson(t, "B", "2024-10-07 14:00", "2024-10-07 16:00"), "331_2", "AULA 2", 200), // Shared lesson
+	}
+
+	index := NewRoomIndex(first, second)
+	if index.Len() != 3 {
+		t.Fatal("wrong number of rooms", index.Len())
+	}
+	if occupancy := index.Occupancy("331_2"); len(occupancy) != 1 {
+		t.Error("shared lessons should be indexed once", len(occupancy))
+	}
+
+	free := index.Free(RoomQuery{
+		Building: "risorgimento",
+		From:     rome(t, "2024-10-07 14:00"),
+		To:       rome(t, "2024-10-07 16:00"),
+	})
+	if len(free) != 2 || free[0].Code != "331_1" || free[1].Code != "331_3" {
+		t.Error("wrong free rooms", free)
+	}
+
+	free = index.Free(RoomQuery{
+		Building: "331",
+		From:     rome(t, "2024-10-07 14:00"),
+		To:       rome(t, "2024-10-07 16:00"),
+		MinSeats: 50,
+	})
+	if len(free) != 1 || free[0].Name != "AULA 1" {
+		t.Error("rooms with few seats should be excluded", free)
+	}
+
+	if free := index.Free(RoomQuery{Building: "navile"}); len(free) != 0 {
+		t.Error("rooms of other buildings should be excluded", free)
+	}
+}
@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"slices"
+	"time"
+)
+
+// WorkingHours are the hours of the day in which free slots are searched.
+type WorkingHours struct {
+	Start    time.Duration  // The start of the day, since midnight, e.g. 9*time.Hour
+	End      time.Duration  // The end of the day, since midnight, e.g. 18*time.Hour + 30*time.Minute
+	Weekdays []time.Weekday // The days considered. If empty, Monday to Friday are used.
+}
+
+// DefaultWorkingHours are the hours in which lessons are usually held: from
+// 8:00 to 19:00, Monday to Friday.
+var DefaultWorkingHours = WorkingHours{Start: 8 * time.Hour, End: 19 * time.Hour}
+
+func (h WorkingHours) includes(day time.Weekday) bool {
+	if len(h.Weekdays) == 0 {
+		return day >= time.Monday && day <= time.Friday
+	}
+	return slices.Contains(h.Weekdays, day)
+}
+
+// Gap is the time between two consecutive lessons on the same day.
+type Gap struct {
+	Interval
+	Before Event // The lesson ending when the gap starts
+	After  Event // The lesson starting when the gap ends
+}
+
+// location returns the timezone of the timetables, falling back to the local
+// one if the Italian timezone is not available.
+func location() *time.Location {
+	if err := cacheTimezone(); err != nil {
+		return time.Local
+	}
+	return cachedTimezone
+}
+
+// at returns the time at the given offset since the midnight of day, in the
+// location of day. The offset is applied to the wall clock, so it is correct
+// on the days when daylight saving time changes.
+func at(day time.Time, offset time.Duration) time.Time {
+	y, m, d := day.Date()
+	return time.Date(y, m, d, int(offset/time.Hour), 0, 0, 0, day.Location()).Add(offset % time.Hour)
+}
+
+// Merge returns the events of all the timetables, sorted by start time.
+func Merge(timetables ...Timetable) Timetable {
+	var merged Timetable
+	for _, t := range timetables {
+		merged = append(merged, t...)
+	}
+	slices.SortStableFunc(merged, func(a, b Event) int {
+		return a.Start.Compare(b.Start.Time)
+	})
+	return merged
+}
+
+// busy returns the intervals covered by the events, sorted and with the
+// overlapping ones merged.
+func (t Timetable) busy() []Interval {
+	intervals := make([]Interval, 0, len(t))
+	for _, e := range t {
+		if e.End.After(e.Start.Time) {
+			intervals = append(intervals, Interval{Start: e.Start.Time, End: e.End.Time})
+		}
+	}
+	slices.SortFunc(intervals, func(a, b Interval) int { return a.Start.Compare(b.Start) })
+
+	merged := intervals[:0]
+	for _, i := range intervals {
+		if n := len(merged); n > 0 && !i.Start.After(merged[n-1].End) {
+			if i.End.After(merged[n-1].End) {
+				merged[n-1].End = i.End
+			}
+			continue
+		}
+		merged = append(merged, i)
+	}
+	return merged
+}
+
+// FreeSlots returns the intervals between from and to, within the working
+// hours, in which there is no event, and which last at least minDuration.
+//
+// The working hours refer to the Europe/Rome timezone, as the events do,
+// and the returned intervals are in that timezone.
+func (t Timetable) FreeSlots(from, to time.Time, hours WorkingHours, minDuration time.Duration) []Interval {
+	loc := location()
+	busy := t.busy()
+
+	var free []Interval
+	from, to = from.In(loc), to.In(loc)
+	for day := at(from, 0); day.Before(to); day = at(day.AddDate(0, 0, 1), 0) {
+		if !hours.includes(day.Weekday()) {
+			continue
+		}
+
+		window := Interval{Start: at(day, hours.Start), End: at(day, hours.End)}
+		if window.Start.Before(from) {
+			window.Start = from
+		}
+		if window.End.After(to) {
+			window.End = to
+		}
+
+		for _, slot := range subtract(window, busy) {
+			if slot.End.Sub(slot.Start) >= minDuration {
+				free = append(free, slot)
+			}
+		}
+	}
+	return free
+}
+
+// FreeSlots returns the intervals in which none of the timetables has an
+// event, e.g. to find when all the students of different years or curricula
+// are free for a meeting.
+//
+// See Timetable.FreeSlots for the meaning of the parameters.
+func FreeSlots(from, to time.Time, hours WorkingHours, minDuration time.Duration, timetables ...Timetable) []Interval {
+	return Merge(timetables...).FreeSlots(from, to, hours, minDuration)
+}
+
+// subtract returns the parts of window not covered by busy, which must be
+// sorted and not overlapping.
+func subtract(window Interval, busy []Interval) []Interval {
+	var free []Interval
+	start := window.Start
+	for _, b := range busy {
+		if !b.End.After(start) {
+			continue
+		}
+		if !b.Start.Before(window.End) {
+			break
+		}
+		if b.Start.After(start) {
+			free = append(free, Interval{Start: start, End: b.Start})
+		}
+		start = b.End
+	}
+	if start.Before(window.End) {
+		free = append(free, Interval{Start: start, End: window.End})
+	}
+	return free
+}
+
+// Gaps returns the gaps between consecutive lessons on the same day (in the
+// Europe/Rome timezone) lasting at least minDuration, in chronological order.
+// Overlapping lessons are considered as a single block.
+func (t Timetable) Gaps(minDuration time.Duration) []Gap {
+	loc := location()
+	events := Merge(t)
+
+	var gaps []Gap
+	var last *Event // The event ending last in the current block
+	for i := range events {
+		e := &events[i]
+		if last != nil && sameDay(last.End.In(loc), e.Start.In(loc)) {
+			if e.Start.Sub(last.End.Time) >= max(minDuration, 1) {
+				gaps = append(gaps, Gap{
+					Interval: Interval{Start: last.End.In(loc), End: e.Start.In(loc)},
+					Before:   *last,
+					After:    *e,
+				})
+			}
+		}
+		if last == nil || e.End.After(last.End.Time) || !sameDay(last.End.In(loc), e.Start.In(loc)) {
+			last = e
+		}
+	}
+	return gaps
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
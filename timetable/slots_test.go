@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 Eyad Issa <eyadlorenzo@gmail.com>
+//
+// SPDX-License-Identifier: MIT
+
+package timetable
+
+import (
+	"testing"
+	"time"
+)
+
+func rome(t *testing.T, value string) time.Time {
+	t.Helper()
+	loc, err := time.LoadLocation("Europe/Rome")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := time.ParseInLocation("2006-01-02 15:04", value, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func lesson(t *testing.T, code, start, end string) Event {
+	return Event{CodModulo: code, Start: CalendarTime{rome(t, start)}, End: CalendarTime{rome(t, end)}}
+}
+
+func checkIntervals(t *testing.T, got []Interval, want ...string) {
+	t.Helper()
+	if len(got) != len(want)/2 {
+		t.Fatalf("wrong number of intervals: got %v, want %v", got, want)
+	}
+	for i, interval := range got {
+		if !interval.Start.Equal(rome(t, want[2*i])) || !interval.End.Equal(rome(t, want[2*i+1])) {
+			t.Errorf("wrong interval %d: got %v - %v, want %s - %s", i, interval.Start, interval.End, want[2*i], want[2*i+1])
+		}
+	}
+}
+
+func TestFreeSlots(t *testing.T) {
+	tt := Timetable{
+		lesson(t, "A", "2024-10-07 09:00", "2024-10-07 11:00"),
+		lesson(t, "B", "2024-10-07 10:00", "2024-10-07 12:00"), // Overlaps A
+		lesson(t, "C", "2024-10-07 14:00", "2024-10-07 18:30"),
+	}
+	hours := WorkingHours{Start: 8*time.Hour + 30*time.Minute, End: 19 * time.Hour}
+
+	// From Monday to Sunday: the weekend is skipped
+	free := tt.FreeSlots(rome(t, "2024-10-07 00:00"), rome(t, "2024-10-13 23:59"), hours, time.Hour)
+	checkIntervals(t, free[:2],
+		"2024-10-07 12:00", "2024-10-07 14:00",
+		"2024-10-08 08:30", "2024-10-08 19:00",
+	)
+	if len(free) != 5 {
+		t.Error("wrong number of free slots", len(free))
+	}
+
+	// Slots shorter than minDuration are dropped: 8:30-9:00 and 18:30-19:00
+	free = tt.FreeSlots(rome(t, "2024-10-07 00:00"), rome(t, "2024-10-07 23:59"), hours, time.Hour)
+	checkIntervals(t, free, "2024-10-07 12:00", "2024-10-07 14:00")
+
+	// The range clips the working hours
+	free = tt.FreeSlots(rome(t, "2024-10-07 13:00"), rome(t, "2024-10-07 20:00"), hours, 0)
+	checkIntervals(t, free,
+		"2024-10-07 13:00", "2024-10-07 14:00",
+		"2024-10-07 18:30", "2024-10-07 19:00",
+	)
+}
+
+func TestFreeSlotsMerged(t *testing.T) {
+	first := Timetable{lesson(t, "A", "2024-10-07 09:00", "2024-10-07 11:00")}
+	second := Timetable{lesson(t, "B", "2024-10-07 13:00", "2024-10-07 15:00")}
+
+	free := FreeSlots(rome(t, "2024-10-07 00:00"), rome(t, "2024-10-08 00:00"), DefaultWorkingHours, time.Hour, first, second)
+	checkIntervals(t, free,
+		"2024-10-07 08:00", "2024-10-07 09:00",
+		"2024-10-07 11:00", "2024-10-07 13:00",
+		"2024-10-07 15:00", "2024-10-07 19:00",
+	)
+}
+
+func TestFreeSlotsDaylightSaving(t *testing.T) {
+	// On 2024-10-27 the clocks go back one hour: the working hours still
+	// follow the wall clock
+	hours := WorkingHours{Start: 9 * time.Hour, End: 10 * time.Hour, Weekdays: []time.Weekday{time.Sunday}}
+
+	free := Timetable{}.FreeSlots(rome(t, "2024-10-27 00:00"), rome(t, "2024-10-28 00:00"), hours, 0)
+	checkIntervals(t, free, "2024-10-27 09:00", "2024-10-27 10:00")
+}
+
+func TestGaps(t *testing.T) {
+	tt := Timetable{
+		lesson(t, "C", "2024-10-07 14:00", "2024-10-07 16:00"),
+		lesson(t, "A", "2024-10-07 09:00", "2024-10-07 11:00"),
+		lesson(t, "B", "2024-10-07 10:00", "2024-10-07 12:00"), // Overlaps A
+		lesson(t, "D", "2024-10-07 16:15", "2024-10-07 18:00"),
+		lesson(t, "E", "2024-10-08 09:00", "2024-10-08 11:00"), // Next day: no gap
+	}
+
+	gaps := tt.Gaps(30 * time.Minute)
+	if len(gaps) != 1 {
+		t.Fatal("wrong number of gaps", len(gaps))
+	}
+	checkIntervals(t, []Interval{gaps[0].Interval}, "2024-10-07 12:00", "2024-10-07 14:00")
+	if gaps[0].Before.CodModulo != "B" || gaps[0].After.CodModulo != "C" {
+		t.Error("wrong lessons around the gap", gaps[0].Before.CodModulo, gaps[0].After.CodModulo)
+	}
+
+	if gaps := tt.Gaps(0); len(gaps) != 2 {
+		t.Error("wrong number of gaps without a minimum duration", len(gaps))
+	}
+}
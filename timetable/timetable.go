@@ -21,11 +21,13 @@ This package uses the JSON endpoint to retrieve the timetable.
 package timetable
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"strings"
 	"time"
+
+	"github.com/cartabinaria/unibo-go"
 )
 
 var (
@@ -52,6 +54,7 @@ type RawClassroom struct {
 	CreationDate string   `json:"dataCreazione"`
 	Seats        int      `json:"numeroPostazioni"`
 	Description  string   `json:"descrizione"`
+	Code         string   `json:"codice"` // The code of the classroom, unique across the University (e.g. "331_WP02_234")
 	Building     Building `json:"edificio"`
 }
 
@@ -64,7 +67,7 @@ type Building struct {
 	CAP          string `json:"cap"`
 	Description  string `json:"descrizione"`
 	Plesso       string `json:"plesso"`
-	Geo          Geo    `json:"geolocalizzazione"`
+	Geo          Geo    `json:"geo"`
 	CreationDate string `json:"dataCreazione"`
 	EditDate     string `json:"dataModifica"`
 }
@@ -103,6 +106,30 @@ type Interval struct {
 	End   time.Time
 }
 
+// Client fetches timetables from the University website.
+//
+// The zero value uses unibo.DefaultClient.
+type Client struct {
+	client *unibo.Client
+}
+
+// NewClient creates a new timetable Client configured with the given options.
+//
+// unibo.WithBaseURL replaces "https://corsi.unibo.it".
+func NewClient(opts ...unibo.Option) *Client {
+	return &Client{client: unibo.NewClient(opts...)}
+}
+
+// DefaultClient is the Client used by the package-level functions.
+var DefaultClient = &Client{}
+
+func (c *Client) http() *unibo.Client {
+	if c == nil || c.client == nil {
+		return unibo.DefaultClient
+	}
+	return c.client
+}
+
 // GetTimetableUrl returns the URL to fetch the timetable for the given course.
 //
 // See FetchTimetable for the meaning of the parameters.
@@ -110,6 +137,17 @@ func GetTimetableUrl(
 	courseType, courseId, curriculum string,
 	year int,
 	interval *Interval,
+) string {
+	return DefaultClient.GetTimetableUrl(courseType, courseId, curriculum, year, interval)
+}
+
+// GetTimetableUrl returns the URL to fetch the timetable for the given course.
+//
+// See FetchTimetable for the meaning of the parameters.
+func (c *Client) GetTimetableUrl(
+	courseType, courseId, curriculum string,
+	year int,
+	interval *Interval,
 ) string {
 	var orarioLang string
 	if strings.Contains(courseType, "cycle") {
@@ -118,7 +156,7 @@ func GetTimetableUrl(
 		orarioLang = "orario-lezioni"
 	}
 
-	url := fmt.Sprintf(baseUrl+timetablePath, courseType, courseId, orarioLang, year)
+	url := fmt.Sprintf(c.http().BaseURL(baseUrl)+timetablePath, courseType, courseId, orarioLang, year)
 
 	if curriculum != "" {
 		url += fmt.Sprintf("&curricula=%s", curriculum)
@@ -143,12 +181,35 @@ func FetchTimetable(
 	year int,
 	interval *Interval,
 ) (Timetable, error) {
-	url := GetTimetableUrl(courseType, courseId, curriculum, year, interval)
+	return FetchTimetableContext(context.Background(), courseType, courseId, curriculum, year, interval)
+}
 
-	res, err := http.Get(url)
+// FetchTimetableContext is like FetchTimetable, but the request is bound to ctx.
+func FetchTimetableContext(
+	ctx context.Context,
+	courseType, courseId, curriculum string,
+	year int,
+	interval *Interval,
+) (Timetable, error) {
+	return DefaultClient.FetchTimetable(ctx, courseType, courseId, curriculum, year, interval)
+}
+
+// FetchTimetable retrieves the timetable for the given course.
+//
+// See the package-level FetchTimetable for the meaning of the parameters.
+func (c *Client) FetchTimetable(
+	ctx context.Context,
+	courseType, courseId, curriculum string,
+	year int,
+	interval *Interval,
+) (Timetable, error) {
+	url := c.GetTimetableUrl(courseType, courseId, curriculum, year, interval)
+
+	res, err := c.http().Get(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch timetable: %w", err)
 	}
+	defer res.Body.Close()
 
 	var timetable Timetable
 	err = json.NewDecoder(res.Body).Decode(&timetable)
@@ -156,11 +217,6 @@ func FetchTimetable(
 		return nil, fmt.Errorf("failed to decode timetable: %w", err)
 	}
 
-	err = res.Body.Close()
-	if err != nil {
-		return nil, fmt.Errorf("failed to close response body: %w", err)
-	}
-
 	return timetable, nil
 }
 
@@ -184,3 +240,40 @@ func (t Timetable) GetSubjects() (teachings []SimpleSubject) {
 
 	return
 }
+
+// Key returns a stable identifier of the event, made of its split code (or
+// module code) and its start time, e.g. "28004_1--A-K-20230919T0900".
+//
+// The same lesson has the same key in every timetable it appears in.
+func (e Event) Key() string {
+	return e.code() + "-" + e.Start.Format("20060102T1504")
+}
+
+// code returns the split code of the event, or its module code.
+func (e Event) code() string {
+	if e.CodSdoppiamento != "" {
+		return e.CodSdoppiamento
+	}
+	return e.CodModulo
+}
+
+// HasModule reports whether the event belongs to the given module or
+// teaching code. Teaching codes also match their modules, e.g. "28004"
+// matches the module "28004_1".
+func (e Event) HasModule(code string) bool {
+	return e.CodModulo == code || strings.HasPrefix(e.CodModulo, code+"_")
+}
+
+// Dedupe returns the events of the timetable without duplicates, i.e. the
+// events with the same Key, keeping the first one of each.
+func Dedupe(t Timetable) Timetable {
+	seen := make(map[string]bool, len(t))
+	deduped := make(Timetable, 0, len(t))
+	for _, e := range t {
+		if key := e.Key(); !seen[key] {
+			seen[key] = true
+			deduped = append(deduped, e)
+		}
+	}
+	return deduped
+}
@@ -5,9 +5,13 @@
 package timetable
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/cartabinaria/unibo-go"
 )
 
 func TestFetchTimetable(t *testing.T) {
@@ -91,5 +95,81 @@ func TestFetchTimetable(t *testing.T) {
 		t.Error("wrong number of classrooms")
 	} else if timetable[0].Classrooms[0].ResourceDesc != "AULA 6.2" {
 		t.Error("wrong Description")
+	} else if geo := timetable[0].Classrooms[0].Raw.Building.Geo; geo.Lat != 44.4903628 || geo.Lng != 11.3289228 {
+		t.Error("wrong Geo", geo)
+	}
+}
+
+func TestClientFetchTimetable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/laurea/IngegneriaInformatica/orario-lezioni/@@orario_reale_json" {
+			t.Error("wrong path", r.URL.Path)
+		}
+		if r.URL.RawQuery != "anno=2&curricula=A58-000" {
+			t.Error("wrong query", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`[{"cod_modulo":"28004_1","title":"FONDAMENTI DI INFORMATICA T-1","start":"2023-09-19T09:00:00","end":"2023-09-19T12:00:00"}]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(unibo.WithBaseURL(server.URL), unibo.WithHTTPClient(server.Client()))
+
+	timetable, err := client.FetchTimetable(context.Background(), "laurea", "IngegneriaInformatica", "A58-000", 2, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(timetable) != 1 || timetable[0].CodModulo != "28004_1" {
+		t.Error("unexpected timetable", timetable)
+	}
+}
+
+func TestCalendar(t *testing.T) {
+	var timetable Timetable
+	if err := json.Unmarshal([]byte(`[{"cod_modulo":"28004_1","cod_sdoppiamento":"28004_1--A-K","title":"FONDAMENTI DI INFORMATICA T-1","docente":"Paola Mello","cfu":12,"start":"2023-09-19T09:00:00","end":"2023-09-19T12:00:00","aule":[{"des_risorsa":"AULA 6.2","des_indirizzo":"Viale del Risorgimento, 2 - Bologna"}]}]`), &timetable); err != nil {
+		t.Fatal(err)
+	}
+
+	cal := timetable.Calendar()
+	if len(cal.Events) != 1 {
+		t.Fatal("wrong number of events", len(cal.Events))
+	}
+
+	event := cal.Events[0]
+	if event.UID != "28004_1--A-K-20230919T0900@corsi.unibo.it" {
+		t.Error("wrong UID", event.UID)
+	}
+	if event.Location != "AULA 6.2 - Viale del Risorgimento, 2 - Bologna" {
+		t.Error("wrong Location", event.Location)
+	}
+	if event.Description != "Docente: Paola Mello\nCFU: 12" {
+		t.Error("wrong Description", event.Description)
+	}
+	if !event.End.Equal(timetable[0].End.Time) {
+		t.Error("wrong End", event.End)
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	a := lesson(t, "A", "2024-10-07 09:00", "2024-10-07 11:00")
+	b := lesson(t, "B", "2024-10-07 09:00", "2024-10-07 11:00")
+	a2 := lesson(t, "A", "2024-10-08 09:00", "2024-10-08 11:00")
+
+	deduped := Dedupe(Merge(Timetable{a, b}, Timetable{a, a2}))
+	if len(deduped) != 3 {
+		t.Fatal("wrong number of events", len(deduped))
+	}
+	if deduped[0].Key() != a.Key() || deduped[1].Key() != b.Key() || deduped[2].Key() != a2.Key() {
+		t.Error("wrong events", deduped)
+	}
+}
+
+func TestHasModule(t *testing.T) {
+	e := Event{CodModulo: "28004_1"}
+	if !e.HasModule("28004") || !e.HasModule("28004_1") {
+		t.Error("the event should belong to its teaching and module")
+	}
+	if e.HasModule("2800") || e.HasModule("28004_2") {
+		t.Error("the event should not belong to other modules")
 	}
 }
@@ -10,5 +10,17 @@ Package unibo provides a wrapper around the APIs of the University of Bologna.
 Most of the APIs used by this package are not documented and are subject to change.
 
 In some cases, we use html parsing to extract the data we need from the University's website.
+
+# HTTP client
+
+Every request made by this module goes through a Client. Each package exposes
+its own Client, built from the options defined here, and package-level
+functions that use a default one:
+
+	client := timetable.NewClient(unibo.WithTimeout(10 * time.Second))
+	tt, err := client.FetchTimetable(ctx, "laurea", "IngegneriaInformatica", "", 1, nil)
+
+Package-level functions come in two flavors, e.g. FetchTimetable and
+FetchTimetableContext; the latter binds the request to a context.Context.
 */
 package unibo